@@ -0,0 +1 @@
+data "unleash_environments" "all" {}
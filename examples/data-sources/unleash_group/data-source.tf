@@ -0,0 +1,3 @@
+data "unleash_group" "payments" {
+  name = "payments"
+}
@@ -0,0 +1,5 @@
+data "unleash_public_signup_tokens" "all" {}
+
+output "signed_up_users" {
+  value = { for token in data.unleash_public_signup_tokens.all.tokens : token.name => token.users[*].email }
+}
@@ -0,0 +1,3 @@
+data "unleash_segment" "example" {
+  name = "beta-customers"
+}
@@ -0,0 +1 @@
+data "unleash_strategies" "all" {}
@@ -0,0 +1,3 @@
+data "unleash_tag" "teams" {
+  type = "team"
+}
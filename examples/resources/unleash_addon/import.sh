@@ -0,0 +1,3 @@
+# An addon can be imported using its id. Unleash does not return sensitive parameters,
+# so they are imported with a masked value until they are set in the configuration.
+terraform import unleash_addon.slack 1
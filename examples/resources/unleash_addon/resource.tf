@@ -0,0 +1,17 @@
+variable "slack_webhook_url" {
+  type      = string
+  sensitive = true
+}
+
+resource "unleash_addon" "slack" {
+  provider_name = "slack"
+  description   = "Notify the team about production changes"
+  events        = ["feature-created", "feature-environment-enabled", "feature-environment-disabled"]
+  projects      = ["default"]
+  environments  = ["production"]
+
+  parameters = {
+    url            = var.slack_webhook_url
+    defaultChannel = "feature-flags"
+  }
+}
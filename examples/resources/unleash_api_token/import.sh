@@ -0,0 +1,3 @@
+# An api token can be imported using its secret. The projects of the token are imported as they are
+# returned by the server, so declare `projects = ["*"]` for tokens with access to all projects.
+terraform import unleash_api_token.example '*:development.4ff7e7ba0ad3d1e4e57ebdb5b4f3e4bf7b4c9e1e0c6f0dd4a5b6d2c8'
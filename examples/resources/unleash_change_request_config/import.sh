@@ -0,0 +1,2 @@
+# A change request configuration can be imported using the project id and the environment separated by a slash
+terraform import unleash_change_request_config.production default/production
@@ -0,0 +1,5 @@
+resource "unleash_change_request_config" "production" {
+  project_id         = "default"
+  environment        = "production"
+  required_approvals = 2
+}
@@ -0,0 +1,2 @@
+# A context field can be imported using its name
+terraform import unleash_context_field.region region
@@ -0,0 +1,34 @@
+resource "unleash_context_field" "region" {
+  name        = "region"
+  description = "The region the request is coming from"
+  stickiness  = true
+
+  legal_value {
+    value       = "eu"
+    description = "Europe"
+  }
+  legal_value {
+    value       = "us"
+    description = "United States"
+  }
+}
+
+resource "unleash_feature_v2" "example" {
+  name       = "toggle"
+  project_id = "default"
+  type       = "release"
+
+  environment {
+    name    = "development"
+    enabled = true
+
+    strategy {
+      name = "default"
+      constraint {
+        context_name = unleash_context_field.region.name
+        operator     = "IN"
+        values       = ["eu"]
+      }
+    }
+  }
+}
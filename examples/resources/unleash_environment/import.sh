@@ -0,0 +1,2 @@
+# An environment can be imported using its name
+terraform import unleash_environment.staging staging
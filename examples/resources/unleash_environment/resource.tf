@@ -0,0 +1,16 @@
+resource "unleash_environment" "staging" {
+  name       = "staging"
+  type       = "preproduction"
+  sort_order = 2
+}
+
+resource "unleash_feature_v2" "example" {
+  name       = "toggle"
+  project_id = "default"
+  type       = "release"
+
+  environment {
+    name    = unleash_environment.staging.name
+    enabled = true
+  }
+}
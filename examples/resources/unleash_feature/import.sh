@@ -0,0 +1,2 @@
+# A feature can be imported using the project id and the feature name separated by a slash
+terraform import unleash_feature.example default/toggle
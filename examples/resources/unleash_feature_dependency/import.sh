@@ -0,0 +1,2 @@
+# A feature dependency can be imported using the project id, the child feature name and the parent feature name separated by slashes
+terraform import unleash_feature_dependency.express_payment default/express-payment/new-checkout
@@ -0,0 +1,37 @@
+resource "unleash_feature_v2" "checkout" {
+  name       = "new-checkout"
+  project_id = "default"
+  type       = "release"
+
+  environment {
+    name = "production"
+
+    strategy {
+      name = "flexibleRollout"
+      parameters = {
+        rollout    = "100"
+        stickiness = "default"
+        groupId    = "new-checkout"
+      }
+      variant {
+        name = "blue"
+      }
+      variant {
+        name = "green"
+      }
+    }
+  }
+}
+
+resource "unleash_feature_v2" "express_payment" {
+  name       = "express-payment"
+  project_id = "default"
+  type       = "release"
+}
+
+resource "unleash_feature_dependency" "express_payment" {
+  project_id   = "default"
+  feature_name = unleash_feature_v2.express_payment.name
+  parent       = unleash_feature_v2.checkout.name
+  variants     = ["green"]
+}
@@ -0,0 +1,2 @@
+# A feature enabling can be imported using the project id, the feature name and the environment separated by slashes
+terraform import unleash_feature_enabling.example default/toggle/development
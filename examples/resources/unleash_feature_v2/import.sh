@@ -0,0 +1,3 @@
+# A feature can be imported using the project id and the feature name separated by a slash.
+# Environments that are disabled and have no strategies are not imported.
+terraform import unleash_feature_v2.example default/toggle
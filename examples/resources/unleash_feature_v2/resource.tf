@@ -8,6 +8,23 @@ resource "unleash_feature_v2" "with_env_strategies" {
   environment {
     name    = "production"
     enabled = false
+
+    variant {
+      name = "blue"
+      overrides {
+        context_name = "userId"
+        values       = ["alice", "bob"]
+      }
+    }
+    variant {
+      name        = "green"
+      weight      = 300
+      weight_type = "fix"
+      payload {
+        type  = "json"
+        value = jsonencode({ color = "green" })
+      }
+    }
   }
 
   environment {
@@ -59,3 +76,24 @@ resource "unleash_feature_v2" "with_env_strategies" {
     value = "bar"
   }
 }
+
+resource "unleash_feature_v2" "with_change_requests" {
+  name       = "my_reviewed_feature"
+  type       = "release"
+  project_id = "default"
+
+  environment {
+    name           = "production"
+    enabled        = true
+    change_request = true # needs change requests enabled on the environment, see unleash_change_request_config
+
+    strategy {
+      name = "flexibleRollout"
+      parameters = {
+        rollout    = "10"
+        stickiness = "default"
+        groupId    = "my_reviewed_feature"
+      }
+    }
+  }
+}
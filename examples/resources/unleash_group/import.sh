@@ -0,0 +1,2 @@
+# A group can be imported using its numeric id
+terraform import unleash_group.payments 1
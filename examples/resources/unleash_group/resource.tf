@@ -0,0 +1,13 @@
+resource "unleash_user" "bob" {
+  name       = "Bob Joe"
+  email      = "bob.joe@gmail.com"
+  root_role  = "Viewer"
+  send_email = false
+}
+
+resource "unleash_group" "payments" {
+  name         = "payments"
+  description  = "The payments team"
+  mappings_sso = ["payments-team"]
+  users        = [unleash_user.bob.id]
+}
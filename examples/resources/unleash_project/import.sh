@@ -0,0 +1,2 @@
+# A project can be imported using its project id
+terraform import unleash_project.example payments
@@ -0,0 +1,8 @@
+resource "unleash_project" "example" {
+  project_id         = "payments"
+  name               = "Payments"
+  description        = "Feature toggles owned by the payments team"
+  mode               = "open"
+  default_stickiness = "userId"
+  feature_limit      = 50
+}
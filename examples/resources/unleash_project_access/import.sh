@@ -0,0 +1,2 @@
+# Project access can be imported using the project id and the role id separated by a slash
+terraform import unleash_project_access.payments_members payments/5
@@ -0,0 +1,15 @@
+resource "unleash_project" "payments" {
+  project_id = "payments"
+  name       = "Payments"
+}
+
+resource "unleash_group" "payments" {
+  name = "payments"
+}
+
+resource "unleash_project_access" "payments_members" {
+  project_id = unleash_project.payments.project_id
+  role       = "Member"
+  users      = [5, 8]
+  groups     = [unleash_group.payments.id]
+}
@@ -0,0 +1,2 @@
+# A project environment can be imported using the project id and the environment name
+terraform import unleash_project_environment.staging payments/staging
@@ -0,0 +1,23 @@
+resource "unleash_project" "example" {
+  project_id = "payments"
+  name       = "Payments"
+}
+
+resource "unleash_environment" "staging" {
+  name = "staging"
+  type = "preproduction"
+}
+
+resource "unleash_project_environment" "staging" {
+  project_id  = unleash_project.example.project_id
+  environment = unleash_environment.staging.name
+
+  default_strategy {
+    name = "flexibleRollout"
+    parameters = {
+      rollout    = "100"
+      stickiness = "default"
+      groupId    = ""
+    }
+  }
+}
@@ -0,0 +1,2 @@
+# A public signup token can be imported using its secret
+terraform import unleash_public_signup_token.onboarding 0123456789abcdef
@@ -0,0 +1,9 @@
+resource "unleash_public_signup_token" "onboarding" {
+  name       = "payments team onboarding"
+  expires_at = "2030-01-01T00:00:00Z"
+  root_role  = "Viewer"
+}
+
+output "signup_url" {
+  value = unleash_public_signup_token.onboarding.url
+}
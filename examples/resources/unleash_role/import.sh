@@ -0,0 +1,2 @@
+# A custom role can be imported using its numeric id
+terraform import unleash_role.auditor 5
@@ -0,0 +1,30 @@
+resource "unleash_role" "release_manager" {
+  name        = "Release manager"
+  description = "Can enable and disable features in production"
+  type        = "project"
+
+  permission {
+    name        = "UPDATE_FEATURE_ENVIRONMENT"
+    environment = "production"
+  }
+  permission {
+    name = "UPDATE_FEATURE"
+  }
+}
+
+resource "unleash_role" "auditor" {
+  name = "Auditor"
+  type = "root"
+
+  permission {
+    name = "READ_LOGS"
+  }
+}
+
+resource "unleash_user" "alice" {
+  name       = "Alice"
+  email      = "alice@example.com"
+  username   = "alice"
+  root_role  = unleash_role.auditor.id # roles can be referenced by id or name
+  send_email = false
+}
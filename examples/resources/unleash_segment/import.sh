@@ -0,0 +1,2 @@
+# A segment can be imported using its numeric id
+terraform import unleash_segment.beta_customers 1
@@ -0,0 +1,31 @@
+resource "unleash_segment" "beta_customers" {
+  name        = "beta-customers"
+  description = "Customers enrolled in the beta program"
+
+  constraint {
+    context_name = "userId"
+    operator     = "IN"
+    values       = ["alice", "bob"]
+  }
+}
+
+resource "unleash_feature_v2" "example" {
+  name       = "toggle"
+  project_id = "default"
+  type       = "release"
+
+  environment {
+    name    = "development"
+    enabled = true
+
+    strategy {
+      name = "flexibleRollout"
+      parameters = {
+        rollout    = "100"
+        stickiness = "default"
+        groupId    = "toggle"
+      }
+      segments = [unleash_segment.beta_customers.id]
+    }
+  }
+}
@@ -0,0 +1,2 @@
+# A service account can be imported using its numeric id
+terraform import unleash_service_account.ci 7
@@ -0,0 +1,5 @@
+resource "unleash_service_account" "ci" {
+  name      = "CI pipeline"
+  username  = "ci"
+  root_role = "Editor"
+}
@@ -0,0 +1,3 @@
+# A service account token can be imported using the service account id and the token id separated by a slash.
+# The secret is only returned when the token is created, so it is empty for imported tokens.
+terraform import unleash_service_account_token.ci 7/3
@@ -0,0 +1,11 @@
+resource "unleash_service_account" "ci" {
+  name      = "CI pipeline"
+  username  = "ci"
+  root_role = "Editor"
+}
+
+resource "unleash_service_account_token" "ci" {
+  service_account_id = unleash_service_account.ci.id
+  description        = "used by the deployment pipeline"
+  expires_at         = "2030-01-01T00:00:00Z"
+}
@@ -0,0 +1,2 @@
+# A custom strategy can be imported using its name
+terraform import unleash_strategy.example tenant
@@ -0,0 +1,27 @@
+resource "unleash_strategy" "example" {
+  name        = "tenant"
+  title       = "Tenant"
+  description = "Enables the feature for a list of tenants"
+
+  parameter {
+    name        = "tenants"
+    type        = "list"
+    description = "The tenants the feature is enabled for"
+    required    = true
+  }
+  parameter {
+    name = "rollout"
+    type = "percentage"
+  }
+}
+
+resource "unleash_strategy_assignment" "example" {
+  feature_name  = "toggle"
+  project_id    = "default"
+  environment   = "development"
+  strategy_name = unleash_strategy.example.name
+  parameters = {
+    tenants = "acme,globex"
+    rollout = "50"
+  }
+}
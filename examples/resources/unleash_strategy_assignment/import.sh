@@ -0,0 +1,3 @@
+# A strategy assignment can be imported using the project id, the feature name, the environment
+# and the strategy id separated by slashes
+terraform import unleash_strategy_assignment.example default/toggle/development/2fd3c1b8-7c48-4e9f-9d1c-0f1b5c6a3e2d
@@ -22,6 +22,12 @@ resource "unleash_strategy_assignment" "example" {
     stickiness = "random"
     groupId    = "toggle"
   }
+  segments = [1] # ids of existing segments
+  constraint {
+    context_name = "appName"
+    operator     = "IN"
+    values       = ["checkout", "cart"]
+  }
   variant {
     name = "a"
   }
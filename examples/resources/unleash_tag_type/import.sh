@@ -0,0 +1,2 @@
+# A tag type can be imported using its name
+terraform import unleash_tag_type.team team
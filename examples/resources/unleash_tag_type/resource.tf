@@ -0,0 +1,15 @@
+resource "unleash_tag_type" "team" {
+  name        = "team"
+  description = "The team owning the feature"
+}
+
+resource "unleash_feature_v2" "example" {
+  name       = "toggle"
+  project_id = "default"
+  type       = "release"
+
+  tag {
+    type  = unleash_tag_type.team.name
+    value = "payments"
+  }
+}
@@ -0,0 +1,2 @@
+# A user can be imported using its numeric id
+terraform import unleash_user.my_user 5
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	openapiclient "github.com/Unleash/unleash-server-api-go/client"
+)
+
+// adminRequest calls an Unleash admin API endpoint that is not covered by the generated
+// unleash client. It reuses the server URL, headers and HTTP client of the given client.
+// The body is sent as JSON when not nil, and the response is decoded into result when not nil.
+func adminRequest(ctx context.Context, client *openapiclient.APIClient, method string, path string, body interface{}, result interface{}) (*http.Response, error) {
+	config := client.GetConfig()
+
+	serverUrl, err := config.ServerURL(0, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reqBody = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(serverUrl, "/")+path, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range config.DefaultHeader {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	httpClient := config.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return resp, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp, err
+	}
+	if resp.StatusCode >= 300 {
+		return resp, &adminApiError{method: method, path: path, status: resp.Status, statusCode: resp.StatusCode, body: strings.TrimSpace(string(respBody))}
+	}
+	if result != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, result); err != nil {
+			return resp, err
+		}
+	}
+
+	return resp, nil
+}
+
+// adminApiError is returned by adminRequest when unleash answers with an error status, and keeps the status and body of
+// the response for the callers that handle some errors.
+type adminApiError struct {
+	method     string
+	path       string
+	status     string
+	statusCode int
+	body       string
+}
+
+func (e *adminApiError) Error() string {
+	return fmt.Sprintf("%s %s: %s %s", e.method, e.path, e.status, e.body)
+}
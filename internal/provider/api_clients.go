@@ -1,11 +1,37 @@
-package provider
-
-import (
-	"github.com/Unleash/unleash-server-api-go/client"
-	"github.com/philips-labs/go-unleash-api/v2/api"
-)
-
-type ApiClients struct {
-	PhilipsUnleashClient *api.ApiClient
-	UnleashClient        *client.APIClient
-}
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Unleash/unleash-server-api-go/client"
+	"github.com/philips-labs/go-unleash-api/v2/api"
+)
+
+type ApiClients struct {
+	PhilipsUnleashClient *api.ApiClient
+	UnleashClient        *client.APIClient
+	// Server is detected once when the provider is configured
+	Server ServerInfo
+
+	httpClient *http.Client
+	apiUrl     string
+	apiToken   string
+}
+
+// philipsClient returns a philips unleash client whose requests are bound to the given context, as the client does not
+// take one. The requests are then aborted when the operation times out or terraform is interrupted.
+func (c *ApiClients) philipsClient(ctx context.Context) *api.ApiClient {
+	if c.httpClient == nil {
+		return c.PhilipsUnleashClient
+	}
+	httpClient := &http.Client{
+		Transport: &contextTransport{ctx: ctx, next: c.httpClient.Transport},
+	}
+	apiClient, err := api.NewClient(httpClient, c.apiUrl, c.apiToken)
+	if err != nil {
+		// Can not happen, the same URL was used to build PhilipsUnleashClient when configuring the provider
+		return c.PhilipsUnleashClient
+	}
+	return apiClient
+}
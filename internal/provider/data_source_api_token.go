@@ -79,15 +79,12 @@ func dataSourceApiToken() *schema.Resource {
 }
 
 func dataSourceApiTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*ApiClients).UnleashClient
-
 	var diags diag.Diagnostics
 
-	resp, _, err := client.APITokensAPI.GetAllApiTokens(ctx).Execute()
+	allTokens, err := getApiTokens(ctx, meta.(*ApiClients))
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	allTokens := resp.Tokens
 
 	tokenName := d.Get("token_name").(string)
 	projects := d.Get("projects").(*schema.Set).List()
@@ -80,15 +80,12 @@ func dataSourceApiTokens() *schema.Resource {
 }
 
 func dataSourceApiTokensRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*ApiClients).UnleashClient
-
 	var diags diag.Diagnostics
 
-	resp, _, err := client.APITokensAPI.GetAllApiTokens(ctx).Execute()
+	allTokens, err := getApiTokens(ctx, meta.(*ApiClients))
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	allTokens := resp.Tokens
 
 	u, uOk := d.GetOk("token_name")
 	p, pOk := d.GetOk("projects")
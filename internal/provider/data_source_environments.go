@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceEnvironments() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Retrieve all the environments of the unleash instance",
+
+		ReadContext: dataSourceEnvironmentsRead,
+
+		// This descriptions are used by the documentation generator and the language server.
+		Schema: map[string]*schema.Schema{
+			"environments": {
+				Description: "The list of unleash environments",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "The environment name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"type": {
+							Description: "The environment type.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"enabled": {
+							Description: "Whether the environment is enabled.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"protected": {
+							Description: "Whether the environment is protected.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"sort_order": {
+							Description: "The position of the environment in the list of environments.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceEnvironmentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	resp, _, err := client.EnvironmentsAPI.GetAllEnvironments(ctx).Execute()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("environments")
+
+	envs := []interface{}{}
+	for _, env := range resp.Environments {
+		tfMap := map[string]interface{}{}
+		tfMap["name"] = env.Name
+		tfMap["type"] = env.Type
+		tfMap["enabled"] = env.Enabled
+		tfMap["protected"] = env.Protected
+		tfMap["sort_order"] = env.SortOrder
+		envs = append(envs, tfMap)
+	}
+	_ = d.Set("environments", envs)
+
+	return diags
+}
@@ -73,7 +73,7 @@ func dataSourceFeature() *schema.Resource {
 }
 
 func dataSourceFeatureRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*ApiClients).PhilipsUnleashClient
+	client := meta.(*ApiClients).philipsClient(ctx)
 
 	var diags diag.Diagnostics
 
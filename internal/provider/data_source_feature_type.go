@@ -42,7 +42,7 @@ func dataSourceFeatureType() *schema.Resource {
 }
 
 func dataSourceFeatureTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*ApiClients).PhilipsUnleashClient
+	client := meta.(*ApiClients).philipsClient(ctx)
 
 	var diags diag.Diagnostics
 
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/philips-labs/go-unleash-api/v2/api"
+)
+
+func dataSourceGroup() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Retrieve details of an existing user group",
+
+		ReadContext: dataSourceGroupRead,
+
+		// This descriptions are used by the documentation generator and the language server.
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The name of the group",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "The description of the group",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"root_role": {
+				Description: "The id of the root role granted to the members of the group. 0 when the group has no root role.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"mappings_sso": {
+				Description: "The SSO group names mapped to the group",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"users": {
+				Description: "The ids of the members of the group",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	var allGroups groups
+	_, err := adminRequest(ctx, client, http.MethodGet, "/api/admin/groups", nil, &allGroups)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+
+	var foundGroup *group
+	for i, g := range allGroups.Groups {
+		if g.Name == name {
+			foundGroup = &allGroups.Groups[i]
+			break
+		}
+	}
+	if foundGroup == nil {
+		return diag.FromErr(api.ErrNotFound)
+	}
+
+	d.SetId(strconv.Itoa(foundGroup.Id))
+	tfGroup := flattenGroup(*foundGroup)
+	_ = d.Set("description", tfGroup["description"])
+	_ = d.Set("root_role", tfGroup["root_role"])
+	_ = d.Set("mappings_sso", tfGroup["mappings_sso"])
+	_ = d.Set("users", tfGroup["users"])
+
+	return diags
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGroups() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Retrieve all the user groups of the unleash instance",
+
+		ReadContext: dataSourceGroupsRead,
+
+		// This descriptions are used by the documentation generator and the language server.
+		Schema: map[string]*schema.Schema{
+			"groups": {
+				Description: "The list of user groups",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The group id.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The group name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "The group description.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"root_role": {
+							Description: "The id of the root role granted to the members of the group. 0 when the group has no root role.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"mappings_sso": {
+							Description: "The SSO group names mapped to the group.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"users": {
+							Description: "The ids of the members of the group.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	var allGroups groups
+	_, err := adminRequest(ctx, client, http.MethodGet, "/api/admin/groups", nil, &allGroups)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("groups")
+
+	tfGroups := []interface{}{}
+	for _, g := range allGroups.Groups {
+		tfGroups = append(tfGroups, flattenGroup(g))
+	}
+	_ = d.Set("groups", tfGroups)
+
+	return diags
+}
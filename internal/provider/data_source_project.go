@@ -55,7 +55,7 @@ func dataSourceProject() *schema.Resource {
 }
 
 func dataSourceProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*ApiClients).PhilipsUnleashClient
+	client := meta.(*ApiClients).philipsClient(ctx)
 
 	var diags diag.Diagnostics
 
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourcePublicSignupTokens() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Retrieve the public signup tokens of the unleash instance and the users who signed up with them",
+
+		ReadContext: dataSourcePublicSignupTokensRead,
+
+		// This descriptions are used by the documentation generator and the language server.
+		Schema: map[string]*schema.Schema{
+			"tokens": {
+				Description: "The list of public signup tokens",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "The token name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"url": {
+							Description: "The signup URL of the token.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"enabled": {
+							Description: "Whether the token can be used to sign up.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"expires_at": {
+							Description: "The token expiration date.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"created_at": {
+							Description: "The token creation date.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"created_by": {
+							Description: "The user who created the token.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"root_role": {
+							Description: "The name of the root role given to the users signing up with the token.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"users": {
+							Description: "The users who signed up with the token.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Description: "The user id.",
+										Type:        schema.TypeInt,
+										Computed:    true,
+									},
+									"name": {
+										Description: "The user's name.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"email": {
+										Description: "The user's email address.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"username": {
+										Description: "The user's username.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePublicSignupTokensRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	var allTokens publicSignupTokens
+	_, err := adminRequest(ctx, client, http.MethodGet, "/api/admin/invite-link/tokens", nil, &allTokens)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("public_signup_tokens")
+
+	tfTokens := []interface{}{}
+	for _, token := range allTokens.Tokens {
+		tfToken := map[string]interface{}{}
+		tfToken["name"] = token.Name
+		tfToken["url"] = token.Url
+		tfToken["enabled"] = token.Enabled
+		tfToken["expires_at"] = token.ExpiresAt
+		tfToken["created_at"] = token.CreatedAt
+		tfToken["created_by"] = token.CreatedBy
+		tfToken["root_role"] = token.Role.Name
+
+		tfUsers := []interface{}{}
+		for _, user := range token.Users {
+			tfUser := map[string]interface{}{}
+			tfUser["id"] = user.Id
+			tfUser["name"] = user.Name
+			tfUser["email"] = user.Email
+			tfUser["username"] = user.Username
+			tfUsers = append(tfUsers, tfUser)
+		}
+		tfToken["users"] = tfUsers
+
+		tfTokens = append(tfTokens, tfToken)
+	}
+	_ = d.Set("tokens", tfTokens)
+
+	return diags
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/philips-labs/go-unleash-api/v2/api"
+)
+
+func dataSourceSegment() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Retrieve details of an existing segment",
+
+		ReadContext: dataSourceSegmentRead,
+
+		// This descriptions are used by the documentation generator and the language server.
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The name of the segment",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "The description of the segment",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"project_id": {
+				Description: "The project the segment is scoped to. Empty when the segment is available in all projects.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"constraint": {
+				Description: "The constraints of the segment",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"context_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"operator": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"case_insensitive": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"inverted": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSegmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	var allSegments segments
+	_, err := adminRequest(ctx, client, http.MethodGet, "/api/admin/segments", nil, &allSegments)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+
+	var foundSegment *segment
+	for i, s := range allSegments.Segments {
+		if s.Name == name {
+			foundSegment = &allSegments.Segments[i]
+			break
+		}
+	}
+	if foundSegment == nil {
+		return diag.FromErr(api.ErrNotFound)
+	}
+
+	d.SetId(strconv.Itoa(foundSegment.Id))
+	_ = d.Set("description", foundSegment.Description)
+	if foundSegment.Project != nil {
+		_ = d.Set("project_id", *foundSegment.Project)
+	}
+	_ = d.Set("constraint", flattenConstraints(foundSegment.Constraints))
+
+	return diags
+}
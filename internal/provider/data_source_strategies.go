@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceStrategies() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Retrieve all the activation strategies, built-in and custom, of the unleash instance",
+
+		ReadContext: dataSourceStrategiesRead,
+
+		// This descriptions are used by the documentation generator and the language server.
+		Schema: map[string]*schema.Schema{
+			"strategies": {
+				Description: "The list of activation strategies",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "The strategy name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"title": {
+							Description: "The strategy title.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "The strategy description.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"editable": {
+							Description: "Whether the strategy can be changed. Built-in strategies are not editable.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"deprecated": {
+							Description: "Whether the strategy is deprecated.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"parameter": {
+							Description: "The strategy parameter definitions.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"description": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"required": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceStrategiesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	var allStrategies strategyDefinitions
+	_, err := adminRequest(ctx, client, http.MethodGet, "/api/admin/strategies", nil, &allStrategies)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("strategies")
+
+	strategies := []interface{}{}
+	for _, strategy := range allStrategies.Strategies {
+		tfMap := map[string]interface{}{}
+		tfMap["name"] = strategy.Name
+		tfMap["title"] = strategy.Title
+		tfMap["description"] = strategy.Description
+		tfMap["editable"] = strategy.Editable
+		tfMap["deprecated"] = strategy.Deprecated
+		tfMap["parameter"] = flattenStrategyDefinitionParameters(strategy.Parameters)
+		strategies = append(strategies, tfMap)
+	}
+	_ = d.Set("strategies", strategies)
+
+	return diags
+}
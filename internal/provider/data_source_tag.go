@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type tags struct {
+	Tags []struct {
+		Value string `json:"value"`
+		Type  string `json:"type"`
+	} `json:"tags"`
+}
+
+func dataSourceTag() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Retrieve all the tags of a tag type",
+
+		ReadContext: dataSourceTagRead,
+
+		// This descriptions are used by the documentation generator and the language server.
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Description: "The tag type to list the tags of",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"values": {
+				Description: "The values of the tags of this type",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTagRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	tagType := d.Get("type").(string)
+
+	var found tags
+	_, err := adminRequest(ctx, client, http.MethodGet, "/api/admin/tags/"+url.PathEscape(tagType), nil, &found)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(tagType)
+
+	values := []interface{}{}
+	for _, tag := range found.Tags {
+		values = append(values, tag.Value)
+	}
+	_ = d.Set("values", values)
+
+	return diags
+}
@@ -74,11 +74,11 @@ func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, meta interf
 	_ = d.Set("name", userDetails.Name)
 	_ = d.Set("username", userDetails.Username)
 	_ = d.Set("email", userDetails.Email)
-	for k, v := range rolesLookup {
-		if int32(v) == *userDetails.RootRole {
-			_ = d.Set("root_role", k)
-		}
+	roles, err := getRoles(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
 	}
+	_ = d.Set("root_role", roleName(roles, userDetails.GetRootRole()))
 	_ = d.Set("created_at", userDetails.Email)
 	_ = d.Set("image_url", userDetails.ImageUrl)
 
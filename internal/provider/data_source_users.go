@@ -72,7 +72,7 @@ func dataSourceUsers() *schema.Resource {
 }
 
 func dataSourceUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*ApiClients).PhilipsUnleashClient
+	client := meta.(*ApiClients).philipsClient(ctx)
 
 	var diags diag.Diagnostics
 
@@ -84,6 +84,11 @@ func dataSourceUsersRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.FromErr(err)
 	}
 
+	roles, err := getRoles(ctx, meta.(*ApiClients).UnleashClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.SetId(query)
 
 	users := []interface{}{}
@@ -93,11 +98,7 @@ func dataSourceUsersRead(ctx context.Context, d *schema.ResourceData, meta inter
 		tfMap["name"] = userDetails.Name
 		tfMap["username"] = userDetails.Username
 		tfMap["email"] = userDetails.Email
-		for k, v := range rolesLookup {
-			if v == userDetails.RootRole {
-				tfMap["root_role"] = k
-			}
-		}
+		tfMap["root_role"] = roleName(roles, int32(userDetails.RootRole))
 		tfMap["created_at"] = userDetails.CreatedAt
 		tfMap["image_url"] = userDetails.ImageUrl
 		users = append(users, tfMap)
@@ -11,4 +11,10 @@ var (
 	ErrNumberConvertion           = errors.New("the parameter of type number could not be converted, please make sure its a number in string format")
 	ErrBooleanConvertion          = errors.New("the parameter of type boolean could not be converted, please make sure its true or false in string format")
 	ErrMoreThanOneApiToken        = errors.New("the search returned more than one api token")
+	ErrUnknownContextField        = errors.New("the constraint refers to a context field that does not exist on the server")
+	ErrRoleNotFound               = errors.New("the role does not exist on the server")
+	ErrFeatureHasChildren         = errors.New("the feature can not be archived while other features depend on it")
+	ErrInvalidApiUrl              = errors.New("the api_url is not a valid URL")
+	ErrUnsupportedByServer        = errors.New("not supported by the unleash instance")
+	ErrChangeRequestPending       = errors.New("the environment has a pending change request")
 )
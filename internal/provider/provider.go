@@ -2,12 +2,16 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
+	"time"
 
 	openapiclient "github.com/Unleash/unleash-server-api-go/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/philips-labs/go-unleash-api/v2/api"
 )
 
@@ -17,8 +21,19 @@ func init() {
 	schema.DescriptionKind = schema.StringMarkdown
 
 	descriptions = map[string]string{
-		"api_url":    "URL of the unleash API",
-		"auth_token": "Authentication token to authenticate to the Unleash API",
+		"api_url":              "URL of the unleash API",
+		"auth_token":           "Authentication token to authenticate to the Unleash API",
+		"max_retries":          "Maximum number of times a request is retried when unleash rate limits it with HTTP 429. Connection errors and 5xx responses are retried as well for the requests that are safe to repeat. Default is `3`.",
+		"retry_wait_min":       "Minimum time to wait before retrying a request, in seconds. It doubles with every retry. Default is `1`.",
+		"retry_wait_max":       "Maximum time to wait before retrying a request, in seconds. It also limits the wait asked by the `Retry-After` header. Default is `30`.",
+		"request_timeout":      "Timeout of every attempt of a request, in seconds. `0` means no timeout. Default is `30`.",
+		"base_path":            "Path under which unleash is served, such as `/unleash` when a reverse proxy serves it at `https://example.com/unleash/`. When set, the path of `api_url` is ignored. When not set, it is the path of `api_url` without the trailing `/api`.",
+		"ca_cert":              "PEM encoded certificates of the certificate authorities to trust on top of the system ones, or the path of a file containing them.",
+		"client_cert":          "PEM encoded client certificate for mutual TLS, or the path of a file containing it. Requires `client_key`.",
+		"client_key":           "PEM encoded private key of the client certificate, or the path of a file containing it. Requires `client_cert`.",
+		"insecure_skip_verify": "Whether to skip the verification of the server certificate. Only meant for testing. Default is `false`.",
+		"proxy_url":            "URL of the HTTP proxy to connect through. When not set, the `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables are used.",
+		"headers":              "Additional headers to send with every request, such as the ones required by a reverse proxy in front of unleash.",
 	}
 }
 
@@ -39,23 +54,117 @@ func New(version string) func() *schema.Provider {
 					Description: descriptions["auth_token"],
 					DefaultFunc: schema.EnvDefaultFunc("UNLEASH_AUTH_TOKEN", nil),
 				},
+				"max_retries": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      3,
+					Description:  descriptions["max_retries"],
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"retry_wait_min": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      1,
+					Description:  descriptions["retry_wait_min"],
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"retry_wait_max": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      30,
+					Description:  descriptions["retry_wait_max"],
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"request_timeout": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      30,
+					Description:  descriptions["request_timeout"],
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"base_path": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: descriptions["base_path"],
+				},
+				"ca_cert": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: descriptions["ca_cert"],
+				},
+				"client_cert": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  descriptions["client_cert"],
+					RequiredWith: []string{"client_key"},
+				},
+				"client_key": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					Description:  descriptions["client_key"],
+					RequiredWith: []string{"client_cert"},
+				},
+				"insecure_skip_verify": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: descriptions["insecure_skip_verify"],
+				},
+				"proxy_url": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  descriptions["proxy_url"],
+					ValidateFunc: validation.IsURLWithScheme([]string{"http", "https", "socks5"}),
+				},
+				"headers": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Description: descriptions["headers"],
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
 			},
 			DataSourcesMap: map[string]*schema.Resource{
-				"unleash_feature":      dataSourceFeature(),
-				"unleash_project":      dataSourceProject(),
-				"unleash_feature_type": dataSourceFeatureType(),
-				"unleash_users":        dataSourceUsers(),
-				"unleash_user":         dataSourceUser(),
-				"unleash_api_tokens":   dataSourceApiTokens(),
-				"unleash_api_token":    dataSourceApiToken(),
+				"unleash_feature":              dataSourceFeature(),
+				"unleash_project":              dataSourceProject(),
+				"unleash_feature_type":         dataSourceFeatureType(),
+				"unleash_users":                dataSourceUsers(),
+				"unleash_user":                 dataSourceUser(),
+				"unleash_api_tokens":           dataSourceApiTokens(),
+				"unleash_api_token":            dataSourceApiToken(),
+				"unleash_environments":         dataSourceEnvironments(),
+				"unleash_segment":              dataSourceSegment(),
+				"unleash_strategies":           dataSourceStrategies(),
+				"unleash_tag":                  dataSourceTag(),
+				"unleash_group":                dataSourceGroup(),
+				"unleash_groups":               dataSourceGroups(),
+				"unleash_public_signup_tokens": dataSourcePublicSignupTokens(),
 			},
 			ResourcesMap: map[string]*schema.Resource{
-				"unleash_feature":             resourceFeature(),
-				"unleash_feature_v2":          resourceFeatureV2(),
-				"unleash_strategy_assignment": resourceStrategyAssignment(),
-				"unleash_feature_enabling":    resourceFeatureEnabling(),
-				"unleash_user":                resourceUser(),
-				"unleash_api_token":           resourceApiToken(),
+				"unleash_feature":               resourceFeature(),
+				"unleash_feature_v2":            resourceFeatureV2(),
+				"unleash_strategy_assignment":   resourceStrategyAssignment(),
+				"unleash_feature_enabling":      resourceFeatureEnabling(),
+				"unleash_user":                  resourceUser(),
+				"unleash_api_token":             resourceApiToken(),
+				"unleash_project":               resourceProject(),
+				"unleash_environment":           resourceEnvironment(),
+				"unleash_project_environment":   resourceProjectEnvironment(),
+				"unleash_segment":               resourceSegment(),
+				"unleash_context_field":         resourceContextField(),
+				"unleash_strategy":              resourceStrategy(),
+				"unleash_tag_type":              resourceTagType(),
+				"unleash_group":                 resourceGroup(),
+				"unleash_role":                  resourceRole(),
+				"unleash_project_access":        resourceProjectAccess(),
+				"unleash_service_account":       resourceServiceAccount(),
+				"unleash_service_account_token": resourceServiceAccountToken(),
+				"unleash_addon":                 resourceAddon(),
+				"unleash_feature_dependency":    resourceFeatureDependency(),
+				"unleash_change_request_config": resourceChangeRequestConfig(),
+				"unleash_public_signup_token":   resourcePublicSignupToken(),
 			},
 		}
 
@@ -71,7 +180,46 @@ func configure(version string, p *schema.Provider) func(ctx context.Context, d *
 
 		apiUrl := d.Get("api_url").(string)
 		apiToken := d.Get("auth_token").(string)
-		apiClient, err := api.NewClient(&http.Client{}, apiUrl, apiToken)
+
+		headers := map[string]string{}
+		for k, v := range d.Get("headers").(map[string]interface{}) {
+			headers[k] = v.(string)
+		}
+		baseTransport, err := newBaseTransport(transportOptions{
+			caCert:             d.Get("ca_cert").(string),
+			clientCert:         d.Get("client_cert").(string),
+			clientKey:          d.Get("client_key").(string),
+			insecureSkipVerify: d.Get("insecure_skip_verify").(bool),
+			proxyUrl:           d.Get("proxy_url").(string),
+			headers:            headers,
+		})
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		// Both clients share the transport, so the connection settings, retries and timeouts apply to every request
+		httpClient := &http.Client{
+			Transport: newRetryTransport(
+				baseTransport,
+				d.Get("max_retries").(int),
+				time.Duration(d.Get("retry_wait_min").(int))*time.Second,
+				time.Duration(d.Get("retry_wait_max").(int))*time.Second,
+				time.Duration(d.Get("request_timeout").(int))*time.Second,
+			),
+		}
+
+		serverUrl, err := parseServerUrl(apiUrl, d.Get("base_path").(string))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		err = checkServer(ctx, httpClient, serverUrl)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		// Both clients are built from the same server URL, so they always reach the same unleash
+		apiBaseUrl := serverUrl + "/api/"
+		apiClient, err := api.NewClient(httpClient, apiBaseUrl, apiToken)
 		if err != nil {
 			return nil, diag.FromErr(err)
 		}
@@ -79,19 +227,80 @@ func configure(version string, p *schema.Provider) func(ctx context.Context, d *
 		unleashConfig := openapiclient.NewConfiguration()
 		unleashConfig.Servers = openapiclient.ServerConfigurations{
 			openapiclient.ServerConfiguration{
-				URL:         strings.Replace(apiUrl, "/api", "", 1),
+				URL:         serverUrl,
 				Description: "Unleash server",
 			},
 		}
 		unleashConfig.AddDefaultHeader("Authorization", apiToken)
+		unleashConfig.HTTPClient = httpClient
 
 		unleashClient := openapiclient.NewAPIClient(unleashConfig)
 
+		// Assumes the newest API when the version can not be detected, such as with a token that can not read the UI config
+		server, err := detectServer(ctx, unleashClient)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Unable to detect the unleash version",
+				Detail:   "The provider assumes the newest version of Unleash Open Source. Server error: " + err.Error(),
+			})
+		}
+
 		clients := &ApiClients{
 			PhilipsUnleashClient: apiClient,
 			UnleashClient:        unleashClient,
+			Server:               server,
+			httpClient:           httpClient,
+			apiUrl:               apiBaseUrl,
+			apiToken:             apiToken,
 		}
 
 		return clients, diags
 	}
 }
+
+// parseServerUrl returns the URL unleash is served at, without a trailing slash. It is the api_url without its trailing
+// /api path segment, or the scheme and host of the api_url followed by the base path when one is given.
+func parseServerUrl(apiUrl string, basePath string) (string, error) {
+	parsedUrl, err := url.Parse(apiUrl)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidApiUrl, err)
+	}
+	if (parsedUrl.Scheme != "http" && parsedUrl.Scheme != "https") || parsedUrl.Host == "" {
+		return "", fmt.Errorf("%w: %s must be an http or https URL with a host", ErrInvalidApiUrl, apiUrl)
+	}
+
+	path := strings.TrimRight(parsedUrl.Path, "/")
+	if basePath != "" {
+		path = "/" + strings.Trim(basePath, "/")
+	} else {
+		path = strings.TrimSuffix(path, "/api")
+	}
+	path = strings.TrimRight(path, "/")
+
+	serverUrl := url.URL{
+		Scheme: parsedUrl.Scheme,
+		User:   parsedUrl.User,
+		Host:   parsedUrl.Host,
+		Path:   path,
+	}
+	return serverUrl.String(), nil
+}
+
+// checkServer makes sure the server can be reached, to fail early on a wrong api_url rather than on the first request.
+func checkServer(ctx context.Context, httpClient *http.Client, serverUrl string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, serverUrl+"/health", nil)
+	if err != nil {
+		return err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("unleash can not be reached at %s: %w", serverUrl, err)
+	}
+	resp.Body.Close()
+	// A wrong base path or a reverse proxy that can not reach unleash still answers, but not with unleash's health
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unleash can not be reached at %s: GET %s returned %s", serverUrl, req.URL, resp.Status)
+	}
+	return nil
+}
@@ -1,10 +1,22 @@
 package provider
 
 import (
+	"context"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
+	"sync"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 // providerFactories are used to instantiate a provider during acceptance testing.
@@ -22,6 +34,145 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+func TestProviderConfigureTLS(t *testing.T) {
+	var mu sync.Mutex
+	seen := map[string]http.Header{}
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		seen[r.URL.Path] = r.Header.Clone()
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/health":
+			fmt.Fprint(w, `{"health":"GOOD"}`)
+		case "/api/admin/ui-config":
+			fmt.Fprint(w, `{"version":"5.7.0"}`)
+		default:
+			fmt.Fprint(w, `{}`)
+		}
+	}))
+	// The handshake of the untrusted client fails on purpose
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
+	server.StartTLS()
+	t.Cleanup(server.Close)
+	caCert := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	config := func(caCert string) *terraform.ResourceConfig {
+		raw := map[string]interface{}{
+			"api_url":    server.URL + "/api",
+			"auth_token": "token",
+			"headers":    map[string]interface{}{"X-Test": "yes"},
+			// Keeps the failing configuration from waiting on retries
+			"max_retries": 0,
+		}
+		if caCert != "" {
+			raw["ca_cert"] = caCert
+		}
+		return terraform.NewResourceConfigRaw(raw)
+	}
+
+	t.Run("untrusted certificate", func(t *testing.T) {
+		p := New("dev")()
+		diags := p.Configure(context.Background(), config(""))
+		if !diags.HasError() {
+			t.Fatal("expected the server certificate to be rejected without ca_cert")
+		}
+	})
+
+	t.Run("ca_cert and headers", func(t *testing.T) {
+		p := New("dev")()
+		diags := p.Configure(context.Background(), config(caCert))
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		clients := p.Meta().(*ApiClients)
+		if clients.Server.Version != "5.7.0" {
+			t.Errorf("server version = %q, want 5.7.0", clients.Server.Version)
+		}
+		// The unleash client read the UI config while configuring, the philips client has not made a request yet
+		_, _, err := clients.philipsClient(context.Background()).FeatureToggles.GetFeatureByName("default", "my-feature")
+		if err != nil {
+			t.Fatalf("philips client request failed: %v", err)
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		philipsPath := ""
+		for path := range seen {
+			if strings.HasSuffix(path, "/features/my-feature") {
+				philipsPath = path
+			}
+		}
+		if philipsPath == "" {
+			t.Fatalf("the philips client request did not reach the server, saw %v", seen)
+		}
+		for _, path := range []string{"/health", "/api/admin/ui-config", philipsPath} {
+			header, ok := seen[path]
+			if !ok {
+				t.Errorf("no request to %s", path)
+				continue
+			}
+			if got := header.Get("X-Test"); got != "yes" {
+				t.Errorf("X-Test header of %s = %q, want yes", path, got)
+			}
+		}
+		if got := seen["/api/admin/ui-config"].Get("Authorization"); got != "token" {
+			t.Errorf("Authorization header of the unleash client = %q, want token", got)
+		}
+		if got := seen[philipsPath].Get("Authorization"); got != "token" {
+			t.Errorf("Authorization header of the philips client = %q, want token", got)
+		}
+	})
+}
+
+func TestParseServerUrl(t *testing.T) {
+	tests := []struct {
+		name     string
+		apiUrl   string
+		basePath string
+		want     string
+		wantErr  bool
+	}{
+		{"api path", "https://unleash.example.com/api", "", "https://unleash.example.com", false},
+		{"api path with trailing slash", "https://unleash.example.com/api/", "", "https://unleash.example.com", false},
+		{"api path with trailing slashes", "https://unleash.example.com/api//", "", "https://unleash.example.com", false},
+		{"no path", "https://unleash.example.com", "", "https://unleash.example.com", false},
+		{"root path", "https://unleash.example.com/", "", "https://unleash.example.com", false},
+		{"prefixed api path", "https://api.example.com/unleash/api/", "", "https://api.example.com/unleash", false},
+		{"prefixed path without api", "https://api.example.com/unleash", "", "https://api.example.com/unleash", false},
+		{"port", "http://localhost:4242/api", "", "http://localhost:4242", false},
+		{"base path", "https://api.example.com/unleash/api", "/unleash", "https://api.example.com/unleash", false},
+		{"base path without slashes", "https://api.example.com/api", "unleash", "https://api.example.com/unleash", false},
+		{"base path with trailing slash", "https://api.example.com/api", "/unleash/", "https://api.example.com/unleash", false},
+		{"nested base path", "https://api.example.com", "/tools/unleash", "https://api.example.com/tools/unleash", false},
+		{"base path replaces the path", "https://api.example.com/other/api", "/unleash", "https://api.example.com/unleash", false},
+		{"root base path", "https://api.example.com/unleash/api", "/", "https://api.example.com", false},
+		{"no scheme", "unleash.example.com/api", "", "", true},
+		{"unsupported scheme", "ftp://unleash.example.com/api", "", "", true},
+		{"no host", "https:///api", "", "", true},
+		{"malformed", "https://unleash example.com/api", "", "", true},
+		{"invalid escape", "https://unleash.example.com/%zz", "", "", true},
+		{"empty", "", "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseServerUrl(tt.apiUrl, tt.basePath)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidApiUrl) {
+					t.Errorf("parseServerUrl(%q, %q) error = %v, want %v", tt.apiUrl, tt.basePath, err, ErrInvalidApiUrl)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseServerUrl(%q, %q) unexpected error: %v", tt.apiUrl, tt.basePath, err)
+			}
+			if got != tt.want {
+				t.Errorf("parseServerUrl(%q, %q) = %q, want %q", tt.apiUrl, tt.basePath, got, tt.want)
+			}
+		})
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	// You can add code here to run prior to any test case execution, for example assertions
 	// about the appropriate environment variables being set are common to see in a pre-check
@@ -33,3 +184,26 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("UNLEASH_AUTH_TOKEN must be set for acceptance tests")
 	}
 }
+
+// testAccPreCheckEnterprise skips the test unless UNLEASH_ENTERPRISE is set, for features only available in Unleash Enterprise.
+func testAccPreCheckEnterprise(t *testing.T) {
+	testAccPreCheck(t)
+	if v := os.Getenv("UNLEASH_ENTERPRISE"); v == "" {
+		t.Skip("UNLEASH_ENTERPRISE must be set for acceptance tests of enterprise features")
+	}
+}
+
+// testAccImportStateIdFunc builds a composite import ID by joining the given attributes of a resource with slashes.
+func testAccImportStateIdFunc(resourceName string, attributes ...string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+		parts := make([]string, 0, len(attributes))
+		for _, attribute := range attributes {
+			parts = append(parts, rs.Primary.Attributes[attribute])
+		}
+		return strings.Join(parts, "/"), nil
+	}
+}
@@ -0,0 +1,230 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// maskedAddonParameter is the value unleash returns instead of the sensitive addon parameters.
+const maskedAddonParameter = "*****"
+
+type addon struct {
+	Id           int                    `json:"id,omitempty"`
+	Provider     string                 `json:"provider"`
+	Description  string                 `json:"description"`
+	Enabled      bool                   `json:"enabled"`
+	Parameters   map[string]interface{} `json:"parameters"`
+	Events       []string               `json:"events"`
+	Projects     []string               `json:"projects"`
+	Environments []string               `json:"environments"`
+}
+
+func resourceAddon() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Provides a resource for managing unleash addons, such as webhooks, Slack, Datadog or Microsoft Teams integrations.",
+
+		CreateContext: resourceAddonCreate,
+		ReadContext:   resourceAddonRead,
+		UpdateContext: resourceAddonUpdate,
+		DeleteContext: resourceAddonDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: defaultTimeouts(),
+
+		// The descriptions are used by the documentation generator and the language server.
+		Schema: map[string]*schema.Schema{
+			"provider_name": {
+				Description:  "The addon provider, such as `webhook`, `slack`, `slack-app`, `datadog`, `teams` or `new-relic`. Changing it forces a new resource to be created.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"description": {
+				Description: "The addon description.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"enabled": {
+				Description: "Whether the addon is enabled. Default is `true`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"events": {
+				Description: "The events that trigger the addon, such as `feature-created` or `feature-environment-enabled`.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"projects": {
+				Description: "The projects the addon listens to. Use `*` or leave it empty for all projects.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"environments": {
+				Description: "The environments the addon listens to. Use `*` or leave it empty for all environments.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"parameters": {
+				Description: "The addon parameters, such as the `url` of a webhook. The accepted parameters depend on the provider. " +
+					"Unleash does not return the value of sensitive parameters, so changes to them made outside of terraform are not detected.",
+				Type:      schema.TypeMap,
+				Optional:  true,
+				Sensitive: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceAddonCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	var createdAddon addon
+	resp, err := adminRequest(ctx, client, http.MethodPost, "/api/admin/addons", toAddon(d), &createdAddon)
+	if resp == nil {
+		return diag.FromErr(fmt.Errorf("response is nil: %v", err))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(createdAddon.Id))
+	readDiags := resourceAddonRead(ctx, d, meta)
+	if readDiags != nil {
+		diags = append(diags, readDiags...)
+	}
+
+	return diags
+}
+
+func resourceAddonRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	var foundAddon addon
+	resp, err := adminRequest(ctx, client, http.MethodGet, "/api/admin/addons/"+url.PathEscape(d.Id()), nil, &foundAddon)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("provider_name", foundAddon.Provider)
+	_ = d.Set("description", foundAddon.Description)
+	_ = d.Set("enabled", foundAddon.Enabled)
+	_ = d.Set("events", foundAddon.Events)
+	_ = d.Set("projects", foundAddon.Projects)
+	_ = d.Set("environments", foundAddon.Environments)
+	_ = d.Set("parameters", flattenAddonParameters(d.Get("parameters").(map[string]interface{}), foundAddon.Parameters))
+
+	return diags
+}
+
+func resourceAddonUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	resp, err := adminRequest(ctx, client, http.MethodPut, "/api/admin/addons/"+url.PathEscape(d.Id()), toAddon(d), nil)
+	if resp == nil {
+		return diag.FromErr(fmt.Errorf("response is nil: %v", err))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	readDiags := resourceAddonRead(ctx, d, meta)
+	if readDiags != nil {
+		diags = append(diags, readDiags...)
+	}
+
+	return diags
+}
+
+func resourceAddonDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	_, err := adminRequest(ctx, client, http.MethodDelete, "/api/admin/addons/"+url.PathEscape(d.Id()), nil, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return diags
+}
+
+func toAddon(d *schema.ResourceData) addon {
+	newAddon := addon{
+		Provider:     d.Get("provider_name").(string),
+		Description:  d.Get("description").(string),
+		Enabled:      d.Get("enabled").(bool),
+		Parameters:   d.Get("parameters").(map[string]interface{}),
+		Events:       []string{},
+		Projects:     []string{},
+		Environments: []string{},
+	}
+	for _, event := range d.Get("events").(*schema.Set).List() {
+		newAddon.Events = append(newAddon.Events, event.(string))
+	}
+	for _, project := range d.Get("projects").(*schema.Set).List() {
+		newAddon.Projects = append(newAddon.Projects, project.(string))
+	}
+	for _, environment := range d.Get("environments").(*schema.Set).List() {
+		newAddon.Environments = append(newAddon.Environments, environment.(string))
+	}
+	return newAddon
+}
+
+// flattenAddonParameters keeps the known value of the parameters unleash masks, so they don't show up as changed
+// on every plan. Masked parameters with no known value, as happens on import, keep the masked value. Empty parameters
+// that are not configured are left out.
+func flattenAddonParameters(current map[string]interface{}, parameters map[string]interface{}) map[string]interface{} {
+	tfParameters := map[string]interface{}{}
+	for k, v := range parameters {
+		if v == nil {
+			continue
+		}
+		value := fmt.Sprintf("%v", v)
+		if _, ok := current[k]; !ok && value == "" {
+			continue
+		}
+		if value == maskedAddonParameter {
+			if currentValue, ok := current[k]; ok {
+				value = currentValue.(string)
+			}
+		}
+		tfParameters[k] = value
+	}
+	return tfParameters
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/philips-labs/terraform-provider-unleash/utils"
+)
+
+func TestAccResourceAddon(t *testing.T) {
+	randomSuffix := utils.RandomString(4)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// Initial creation
+				Config: testAccResourceAddonInitial(randomSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_addon.foo", "provider_name", "webhook"),
+					resource.TestCheckResourceAttr("unleash_addon.foo", "description", "webhook "+randomSuffix),
+					resource.TestCheckResourceAttr("unleash_addon.foo", "enabled", "true"),
+					resource.TestCheckResourceAttr("unleash_addon.foo", "events.#", "1"),
+					resource.TestCheckResourceAttr("unleash_addon.foo", "parameters.url", "https://example.com/hooks/"+randomSuffix),
+				),
+			},
+			{
+				// Update configuration
+				Config: testAccResourceAddonUpdated(randomSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updates took effect
+					resource.TestCheckResourceAttr("unleash_addon.foo", "enabled", "false"),
+					resource.TestCheckResourceAttr("unleash_addon.foo", "events.#", "2"),
+					resource.TestCheckResourceAttr("unleash_addon.foo", "projects.#", "1"),
+					resource.TestCheckResourceAttr("unleash_addon.foo", "environments.#", "1"),
+					resource.TestCheckResourceAttr("unleash_addon.foo", "parameters.contentType", "application/json"),
+					// Verify unchanged attributes
+					resource.TestCheckResourceAttr("unleash_addon.foo", "provider_name", "webhook"),
+					resource.TestCheckResourceAttr("unleash_addon.foo", "parameters.url", "https://example.com/hooks/"+randomSuffix),
+				),
+			},
+			{
+				ResourceName:            "unleash_addon.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"parameters"},
+			},
+		},
+	})
+}
+
+func testAccResourceAddonInitial(suffix string) string {
+	return fmt.Sprintf(`
+resource "unleash_addon" "foo" {
+  provider_name = "webhook"
+  description   = "webhook %s"
+  events        = ["feature-created"]
+
+  parameters = {
+    url = "https://example.com/hooks/%s"
+  }
+}`, suffix, suffix)
+}
+
+func testAccResourceAddonUpdated(suffix string) string {
+	return fmt.Sprintf(`
+resource "unleash_addon" "foo" {
+  provider_name = "webhook"
+  description   = "webhook %s"
+  enabled       = false
+  events        = ["feature-created", "feature-archived"]
+  projects      = ["default"]
+  environments  = ["development"]
+
+  parameters = {
+    url         = "https://example.com/hooks/%s"
+    contentType = "application/json"
+  }
+}`, suffix, suffix)
+}
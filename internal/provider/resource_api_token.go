@@ -5,6 +5,7 @@ import (
 	"crypto/md5"
 	"encoding/hex"
 	"fmt"
+	"net/http"
 	"time"
 
 	openapiclient "github.com/Unleash/unleash-server-api-go/client"
@@ -23,10 +24,16 @@ func resourceApiToken() *schema.Resource {
 		UpdateContext: resourceApiTokenUpdate,
 		DeleteContext: resourceApiTokenDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceApiTokenImport,
+		},
+
+		Timeouts: defaultTimeouts(),
+
 		// The descriptions are used by the documentation generator and the language server.
 		Schema: map[string]*schema.Schema{
 			"token_name": {
-				Description: "The unique name of the token. This property replaced `username` in Unleash v5).",
+				Description: "The unique name of the token. This property replaced `username` in Unleash v5, and the provider sends it as `username` to older versions.",
 				Type:        schema.TypeString,
 				Required:    true,
 				ForceNew:    true,
@@ -85,16 +92,27 @@ func resourceApiTokenCreate(ctx context.Context, d *schema.ResourceData, meta in
 	projects := toStringArr(d.Get("projects").(*schema.Set).List())
 	expiresAt := d.Get("expires_at").(string)
 
-	createApiTokenSchema := openapiclient.CreateApiTokenSchema{CreateApiTokenSchemaOneOf2: openapiclient.NewCreateApiTokenSchemaOneOf2(tokenType, tokenName)}
-	createApiTokenSchema.CreateApiTokenSchemaOneOf2.Environment = &environment
-	createApiTokenSchema.CreateApiTokenSchemaOneOf2.Projects = projects
-	createApiTokenSchema.CreateApiTokenSchemaOneOf2.ExpiresAt = nil
+	var parsedExpiresAt *time.Time
 	if expiresAt != "" {
 		res, parseErr := time.Parse(time.RFC3339, expiresAt)
 		if parseErr != nil {
 			return diag.FromErr(parseErr)
 		}
-		createApiTokenSchema.CreateApiTokenSchemaOneOf2.ExpiresAt = &res
+		parsedExpiresAt = &res
+	}
+
+	var createApiTokenSchema openapiclient.CreateApiTokenSchema
+	if meta.(*ApiClients).Server.AtLeast(5, 0) {
+		createApiTokenSchema.CreateApiTokenSchemaOneOf2 = openapiclient.NewCreateApiTokenSchemaOneOf2(tokenType, tokenName)
+		createApiTokenSchema.CreateApiTokenSchemaOneOf2.Environment = &environment
+		createApiTokenSchema.CreateApiTokenSchemaOneOf2.Projects = projects
+		createApiTokenSchema.CreateApiTokenSchemaOneOf2.ExpiresAt = parsedExpiresAt
+	} else {
+		// Unleash versions before 5 name the token with username
+		createApiTokenSchema.CreateApiTokenSchemaOneOf3 = openapiclient.NewCreateApiTokenSchemaOneOf3(tokenType, tokenName)
+		createApiTokenSchema.CreateApiTokenSchemaOneOf3.Environment = &environment
+		createApiTokenSchema.CreateApiTokenSchemaOneOf3.Projects = projects
+		createApiTokenSchema.CreateApiTokenSchemaOneOf3.ExpiresAt = parsedExpiresAt
 	}
 
 	createdToken, resp, err := client.APITokensAPI.CreateApiToken(ctx).CreateApiTokenSchema(createApiTokenSchema).Execute()
@@ -117,16 +135,13 @@ func resourceApiTokenCreate(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceApiTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*ApiClients).UnleashClient
-
 	var diags diag.Diagnostics
 
 	secret := d.Get("secret").(string)
-	resp, _, err := client.APITokensAPI.GetAllApiTokens(ctx).Execute()
+	tokens, err := getApiTokens(ctx, meta.(*ApiClients))
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	tokens := resp.Tokens
 
 	var foundApiToken openapiclient.ApiTokenSchema
 	for _, token := range tokens {
@@ -145,6 +160,34 @@ func resourceApiTokenRead(ctx context.Context, d *schema.ResourceData, meta inte
 	return diags
 }
 
+func resourceApiTokenImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	secret := d.Id()
+	tokens, err := getApiTokens(ctx, meta.(*ApiClients))
+	if err != nil {
+		return nil, err
+	}
+
+	var foundApiToken *openapiclient.ApiTokenSchema
+	for i, token := range tokens {
+		if token.Secret == secret {
+			foundApiToken = &tokens[i]
+			break
+		}
+	}
+	if foundApiToken == nil {
+		return nil, fmt.Errorf("api token not found, the import ID needs to be the token secret")
+	}
+
+	// Read does not refresh these, as they can not change after the token is created
+	_ = d.Set("secret", secret)
+	_ = d.Set("projects", foundApiToken.Projects)
+	if expiresAt, ok := foundApiToken.GetExpiresAtOk(); ok && expiresAt != nil {
+		_ = d.Set("expires_at", expiresAt.Format(time.RFC3339))
+	}
+	d.SetId(toMD5Str(secret))
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceApiTokenUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*ApiClients).UnleashClient
 
@@ -190,6 +233,57 @@ func resourceApiTokenDelete(ctx context.Context, d *schema.ResourceData, meta in
 	return diags
 }
 
+// legacyApiToken is an api token of the unleash versions before 5, which name the token with username rather than tokenName.
+type legacyApiToken struct {
+	Secret      string     `json:"secret"`
+	Username    string     `json:"username"`
+	Type        string     `json:"type"`
+	Environment *string    `json:"environment"`
+	Project     string     `json:"project"`
+	Projects    []string   `json:"projects"`
+	ExpiresAt   *time.Time `json:"expiresAt"`
+	CreatedAt   time.Time  `json:"createdAt"`
+}
+
+// getApiTokens lists the api tokens. The tokens of unleash versions before 5 are read with their legacy fields, as the
+// unleash client requires tokenName.
+func getApiTokens(ctx context.Context, clients *ApiClients) ([]openapiclient.ApiTokenSchema, error) {
+	if clients.Server.AtLeast(5, 0) {
+		resp, _, err := clients.UnleashClient.APITokensAPI.GetAllApiTokens(ctx).Execute()
+		if err != nil {
+			return nil, err
+		}
+		return resp.Tokens, nil
+	}
+
+	var legacyTokens struct {
+		Tokens []legacyApiToken `json:"tokens"`
+	}
+	_, err := adminRequest(ctx, clients.UnleashClient, http.MethodGet, "/api/admin/api-tokens", nil, &legacyTokens)
+	if err != nil {
+		return nil, err
+	}
+	tokens := make([]openapiclient.ApiTokenSchema, 0, len(legacyTokens.Tokens))
+	for _, legacyToken := range legacyTokens.Tokens {
+		username := legacyToken.Username
+		token := openapiclient.ApiTokenSchema{
+			Secret:      legacyToken.Secret,
+			Username:    &username,
+			TokenName:   legacyToken.Username,
+			Type:        legacyToken.Type,
+			Environment: legacyToken.Environment,
+			Project:     legacyToken.Project,
+			Projects:    legacyToken.Projects,
+			CreatedAt:   legacyToken.CreatedAt,
+		}
+		if legacyToken.ExpiresAt != nil {
+			token.SetExpiresAt(*legacyToken.ExpiresAt)
+		}
+		tokens = append(tokens, token)
+	}
+	return tokens, nil
+}
+
 func toStringArr(tfList []interface{}) []string {
 	stringArr := make([]string, 0, len(tfList))
 	for _, v := range tfList {
@@ -39,6 +39,12 @@ func TestAccResourceApiToken(t *testing.T) {
 					resource.TestCheckResourceAttr("unleash_api_token.foo", "projects.#", "1"),
 				),
 			},
+			{
+				ResourceName:      "unleash_api_token.foo",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIdFunc("unleash_api_token.foo", "secret"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -0,0 +1,262 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	openapiclient "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/philips-labs/go-unleash-api/v2/api"
+)
+
+// pendingChangeRequestStates are the states of the change requests that are neither applied nor closed.
+var pendingChangeRequestStates = []string{"Draft", "In review", "Approved", "Scheduled"}
+
+type changeRequestChange struct {
+	Feature string      `json:"feature"`
+	Action  string      `json:"action"`
+	Payload interface{} `json:"payload"`
+}
+
+type changeRequest struct {
+	Id    int    `json:"id"`
+	State string `json:"state"`
+}
+
+func resourceChangeRequestConfig() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Provides a resource for requiring change requests on an environment of a project. Change requests are only available in Unleash Enterprise.",
+
+		CreateContext: resourceChangeRequestConfigCreate,
+		ReadContext:   resourceChangeRequestConfigRead,
+		UpdateContext: resourceChangeRequestConfigUpdate,
+		DeleteContext: resourceChangeRequestConfigDelete,
+
+		CustomizeDiff: requireServerDiff("unleash_change_request_config", 0, 0, editionEnterprise),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceChangeRequestConfigImport,
+		},
+
+		Timeouts: defaultTimeouts(),
+
+		// The descriptions are used by the documentation generator and the language server.
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Description: "The project id. Changing it forces a new resource to be created.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"environment": {
+				Description: "The environment name. Changing it forces a new resource to be created.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"enabled": {
+				Description: "Whether changes to the environment must go through change requests. Default is `true`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"required_approvals": {
+				Description:  "The number of approvals a change request needs before it can be applied. Default is `1`.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func resourceChangeRequestConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	projectId := d.Get("project_id").(string)
+	environment := d.Get("environment").(string)
+
+	err := updateChangeRequestConfig(ctx, meta.(*ApiClients).UnleashClient, projectId, environment, d.Get("enabled").(bool), d.Get("required_approvals").(int))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(projectId + "/" + environment)
+	readDiags := resourceChangeRequestConfigRead(ctx, d, meta)
+	if readDiags != nil {
+		diags = append(diags, readDiags...)
+	}
+
+	return diags
+}
+
+func resourceChangeRequestConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	parts, err := parseImportId(d.Id(), "project/environment")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	projectId, environment := parts[0], parts[1]
+
+	configs, resp, err := client.ChangeRequestsAPI.GetProjectChangeRequestConfig(ctx, projectId).Execute()
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	var foundConfig *openapiclient.ChangeRequestEnvironmentConfigSchema
+	for i, config := range configs {
+		if config.Environment == environment {
+			foundConfig = &configs[i]
+			break
+		}
+	}
+	if foundConfig == nil {
+		d.SetId("")
+		return diags
+	}
+
+	_ = d.Set("project_id", projectId)
+	_ = d.Set("environment", foundConfig.Environment)
+	_ = d.Set("enabled", foundConfig.ChangeRequestEnabled)
+	if requiredApprovals, ok := foundConfig.GetRequiredApprovalsOk(); ok && requiredApprovals != nil {
+		_ = d.Set("required_approvals", int(*requiredApprovals))
+	}
+
+	return diags
+}
+
+func resourceChangeRequestConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	err := updateChangeRequestConfig(ctx, meta.(*ApiClients).UnleashClient, d.Get("project_id").(string), d.Get("environment").(string), d.Get("enabled").(bool), d.Get("required_approvals").(int))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	readDiags := resourceChangeRequestConfigRead(ctx, d, meta)
+	if readDiags != nil {
+		diags = append(diags, readDiags...)
+	}
+
+	return diags
+}
+
+// Disables change requests on the environment
+func resourceChangeRequestConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	err := updateChangeRequestConfig(ctx, meta.(*ApiClients).UnleashClient, d.Get("project_id").(string), d.Get("environment").(string), false, d.Get("required_approvals").(int))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return diags
+}
+
+func resourceChangeRequestConfigImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, err := parseImportId(d.Id(), "project/environment"); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func updateChangeRequestConfig(ctx context.Context, client *openapiclient.APIClient, projectId string, environment string, enabled bool, requiredApprovals int) error {
+	approvals := int32(requiredApprovals)
+
+	config := *openapiclient.NewUpdateChangeRequestEnvironmentConfigSchema(enabled)
+	config.RequiredApprovals = &approvals
+
+	resp, err := client.ChangeRequestsAPI.UpdateProjectChangeRequestConfig(ctx, projectId, environment).UpdateChangeRequestEnvironmentConfigSchema(config).Execute()
+	if resp == nil {
+		return fmt.Errorf("response is nil: %v", err)
+	}
+	return err
+}
+
+// environmentWriteDiags returns the diagnostics of a failed write to a feature environment. When the write was rejected
+// because the environment requires change requests, the diagnostic says so rather than only showing the server error.
+// The response is the one of the failed request, or nil when the request was made with adminRequest.
+func environmentWriteDiags(projectId string, environment string, resp *http.Response, err error) diag.Diagnostics {
+	if err == nil || !isChangeRequestRequired(resp, err) {
+		return diag.FromErr(err)
+	}
+	return diag.Diagnostics{
+		{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("The %s environment of project %s requires change requests", environment, projectId),
+			Detail: "Unleash rejected the change because it has to go through a change request. Set `change_request` on the environment " +
+				"of unleash_feature_v2 to submit it as one, or use a token with the SKIP_CHANGE_REQUEST permission. Server error: " + err.Error(),
+		},
+	}
+}
+
+// isChangeRequestRequired tells whether unleash rejected a write because the environment requires change requests,
+// which it answers with a 403 naming the SKIP_CHANGE_REQUEST permission the token lacks.
+func isChangeRequestRequired(resp *http.Response, err error) bool {
+	var adminErr *adminApiError
+	if errors.As(err, &adminErr) {
+		return adminErr.statusCode == http.StatusForbidden && strings.Contains(adminErr.body, "SKIP_CHANGE_REQUEST")
+	}
+	// The philips client reads the body of the response and puts the server message in its error. When it returns no
+	// response, the status is only in its error as well.
+	message := err.Error()
+	if resp == nil {
+		return strings.Contains(message, strconv.Itoa(http.StatusForbidden)) && strings.Contains(message, "SKIP_CHANGE_REQUEST")
+	}
+	return resp.StatusCode == http.StatusForbidden && strings.Contains(message, "SKIP_CHANGE_REQUEST")
+}
+
+// httpResponse returns the HTTP response of a philips client call, which is nil when the request could not be made.
+func httpResponse(resp *api.Response) *http.Response {
+	if resp == nil {
+		return nil
+	}
+	return resp.Response
+}
+
+// submitChangeRequest adds the changes to the draft change request of the environment, which unleash creates when there
+// is none, and moves it to review when asked to.
+func submitChangeRequest(ctx context.Context, client *openapiclient.APIClient, projectId string, environment string, changes []changeRequestChange, review bool) (*changeRequest, error) {
+	var created changeRequest
+	path := "/api/admin/projects/" + url.PathEscape(projectId) + "/environments/" + url.PathEscape(environment) + "/change-requests"
+	_, err := adminRequest(ctx, client, http.MethodPost, path, changes, &created)
+	if err != nil {
+		return nil, err
+	}
+
+	if review && created.State == "Draft" {
+		path := "/api/admin/projects/" + url.PathEscape(projectId) + "/change-requests/" + strconv.Itoa(created.Id) + "/state"
+		_, err := adminRequest(ctx, client, http.MethodPut, path, map[string]string{"state": "In review"}, nil)
+		if err != nil {
+			return nil, err
+		}
+		created.State = "In review"
+	}
+
+	return &created, nil
+}
+
+func getChangeRequest(ctx context.Context, client *openapiclient.APIClient, projectId string, id int) (*changeRequest, *http.Response, error) {
+	var found changeRequest
+	resp, err := adminRequest(ctx, client, http.MethodGet, "/api/admin/projects/"+url.PathEscape(projectId)+"/change-requests/"+strconv.Itoa(id), nil, &found)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &found, resp, nil
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceChangeRequestConfig(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckEnterprise(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// Initial creation
+				Config: testAccResourceChangeRequestConfigInitial,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_change_request_config.foo", "project_id", "default"),
+					resource.TestCheckResourceAttr("unleash_change_request_config.foo", "environment", "production"),
+					resource.TestCheckResourceAttr("unleash_change_request_config.foo", "enabled", "true"),
+					resource.TestCheckResourceAttr("unleash_change_request_config.foo", "required_approvals", "1"),
+				),
+			},
+			{
+				// Update configuration
+				Config: testAccResourceChangeRequestConfigUpdated,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updates took effect
+					resource.TestCheckResourceAttr("unleash_change_request_config.foo", "required_approvals", "2"),
+					// Verify unchanged attributes
+					resource.TestCheckResourceAttr("unleash_change_request_config.foo", "enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      "unleash_change_request_config.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceChangeRequestConfigOpenSource(t *testing.T) {
+	if os.Getenv("UNLEASH_ENTERPRISE") != "" {
+		t.Skip("the plan only fails against Unleash Open Source")
+	}
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceChangeRequestConfigInitial,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("unleash_change_request_config is only available in Unleash Enterprise"),
+			},
+		},
+	})
+}
+
+const testAccResourceChangeRequestConfigInitial = `
+resource "unleash_change_request_config" "foo" {
+  project_id  = "default"
+  environment = "production"
+}`
+
+const testAccResourceChangeRequestConfigUpdated = `
+resource "unleash_change_request_config" "foo" {
+  project_id         = "default"
+  environment        = "production"
+  required_approvals = 2
+}`
+
+func TestIsChangeRequestRequired(t *testing.T) {
+	const skipChangeRequest = `{"name":"NoAccessError","message":"You need permission=SKIP_CHANGE_REQUEST to perform this action","permission":"SKIP_CHANGE_REQUEST"}`
+	const otherPermission = `{"name":"NoAccessError","message":"You need permission=UPDATE_FEATURE_STRATEGY to perform this action","permission":"UPDATE_FEATURE_STRATEGY"}`
+
+	adminTests := []struct {
+		name   string
+		status int
+		body   string
+		want   bool
+	}{
+		{"skip change request", http.StatusForbidden, skipChangeRequest, true},
+		{"other permission", http.StatusForbidden, otherPermission, false},
+		{"change request text", http.StatusForbidden, `{"message":"the change request could not be created"}`, false},
+		{"other status", http.StatusConflict, skipChangeRequest, false},
+	}
+	for _, tt := range adminTests {
+		t.Run("admin api "+tt.name, func(t *testing.T) {
+			client := newTestUnleashClient(t, tt.status, tt.body)
+			_, err := adminRequest(context.Background(), client, http.MethodGet, "/api/admin/ui-config", nil, nil)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if got := isChangeRequestRequired(nil, err); got != tt.want {
+				t.Errorf("isChangeRequestRequired() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+
+	philipsTests := []struct {
+		name string
+		resp *http.Response
+		err  error
+		want bool
+	}{
+		{"skip change request", &http.Response{StatusCode: http.StatusForbidden}, fmt.Errorf("403 Forbidden: %s", skipChangeRequest), true},
+		{"other permission", &http.Response{StatusCode: http.StatusForbidden}, fmt.Errorf("403 Forbidden: %s", otherPermission), false},
+		{"other status", &http.Response{StatusCode: http.StatusBadRequest}, fmt.Errorf("400 Bad Request: %s", skipChangeRequest), false},
+		{"no response", nil, fmt.Errorf("403 Forbidden: %s", skipChangeRequest), true},
+		{"no response with other permission", nil, fmt.Errorf("403 Forbidden: %s", otherPermission), false},
+		{"no response nor status", nil, errors.New("SKIP_CHANGE_REQUEST"), false},
+	}
+	for _, tt := range philipsTests {
+		t.Run("philips client "+tt.name, func(t *testing.T) {
+			if got := isChangeRequestRequired(tt.resp, tt.err); got != tt.want {
+				t.Errorf("isChangeRequestRequired() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	openapiclient "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/philips-labs/go-unleash-api/v2/api"
+)
+
+// builtInContextFields are always accepted in constraints, even if the server does not list them.
+var builtInContextFields = []string{"appName", "currentTime", "environment", "sessionId", "userId", "remoteAddress"}
+
+func resourceContextField() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Provides a resource for managing unleash context fields, which can be used in strategy constraints.",
+
+		CreateContext: resourceContextFieldCreate,
+		ReadContext:   resourceContextFieldRead,
+		UpdateContext: resourceContextFieldUpdate,
+		DeleteContext: resourceContextFieldDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: defaultTimeouts(),
+
+		// The descriptions are used by the documentation generator and the language server.
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The context field name. Changing it forces a new resource to be created.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"description": {
+				Description: "The context field description.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"stickiness": {
+				Description: "Whether the context field can be used for custom stickiness. Default is `false`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"sort_order": {
+				Description: "How the context field is sorted when no other sort order is selected.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"legal_value": {
+				Description: "Allowed value for the context field. When none is set, any value is allowed.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"value": {
+							Description: "The allowed value.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"description": {
+							Description: "The description of the allowed value.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceContextFieldCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	description := d.Get("description").(string)
+	stickiness := d.Get("stickiness").(bool)
+
+	createContextFieldSchema := *openapiclient.NewCreateContextFieldSchema(d.Get("name").(string))
+	createContextFieldSchema.Description = &description
+	createContextFieldSchema.Stickiness = &stickiness
+	createContextFieldSchema.LegalValues = toLegalValues(d.Get("legal_value").([]interface{}))
+	if v, ok := d.GetOk("sort_order"); ok {
+		sortOrder := int32(v.(int))
+		createContextFieldSchema.SortOrder = &sortOrder
+	}
+
+	createdContextField, resp, err := client.ContextAPI.CreateContextField(ctx).CreateContextFieldSchema(createContextFieldSchema).Execute()
+	if resp == nil {
+		return diag.FromErr(fmt.Errorf("response is nil: %v", err))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdContextField.Name)
+	readDiags := resourceContextFieldRead(ctx, d, meta)
+	if readDiags != nil {
+		diags = append(diags, readDiags...)
+	}
+
+	return diags
+}
+
+func resourceContextFieldRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	contextField, resp, err := client.ContextAPI.GetContextField(ctx, d.Id()).Execute()
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("name", contextField.Name)
+	_ = d.Set("description", contextField.GetDescription())
+	_ = d.Set("stickiness", contextField.GetStickiness())
+	_ = d.Set("sort_order", contextField.GetSortOrder())
+	_ = d.Set("legal_value", flattenLegalValues(contextField.LegalValues))
+
+	return diags
+}
+
+func resourceContextFieldUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	description := d.Get("description").(string)
+	stickiness := d.Get("stickiness").(bool)
+	sortOrder := int32(d.Get("sort_order").(int))
+
+	updateContextFieldSchema := *openapiclient.NewUpdateContextFieldSchema()
+	updateContextFieldSchema.Description = &description
+	updateContextFieldSchema.Stickiness = &stickiness
+	updateContextFieldSchema.SortOrder = &sortOrder
+	updateContextFieldSchema.LegalValues = toLegalValues(d.Get("legal_value").([]interface{}))
+
+	resp, err := client.ContextAPI.UpdateContextField(ctx, d.Id()).UpdateContextFieldSchema(updateContextFieldSchema).Execute()
+	if resp == nil {
+		return diag.FromErr(fmt.Errorf("response is nil: %v", err))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	readDiags := resourceContextFieldRead(ctx, d, meta)
+	if readDiags != nil {
+		diags = append(diags, readDiags...)
+	}
+
+	return diags
+}
+
+func resourceContextFieldDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	_, err := client.ContextAPI.DeleteContextField(ctx, d.Id()).Execute()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return diags
+}
+
+func toLegalValues(tfLegalValues []interface{}) []openapiclient.LegalValueSchema {
+	legalValues := make([]openapiclient.LegalValueSchema, 0, len(tfLegalValues))
+	for _, tfLegalValue := range tfLegalValues {
+		legalValueMap := tfLegalValue.(map[string]interface{})
+		legalValue := *openapiclient.NewLegalValueSchema(legalValueMap["value"].(string))
+		if description := legalValueMap["description"].(string); description != "" {
+			legalValue.Description = &description
+		}
+		legalValues = append(legalValues, legalValue)
+	}
+	return legalValues
+}
+
+func flattenLegalValues(legalValues []openapiclient.LegalValueSchema) []interface{} {
+	tfLegalValues := []interface{}{}
+	for _, legalValue := range legalValues {
+		tfLegalValue := map[string]interface{}{}
+		tfLegalValue["value"] = legalValue.Value
+		tfLegalValue["description"] = legalValue.GetDescription()
+		tfLegalValues = append(tfLegalValues, tfLegalValue)
+	}
+	return tfLegalValues
+}
+
+// validateConstraintContexts makes sure every constraint refers to a context field known by the server.
+func validateConstraintContexts(ctx context.Context, client *openapiclient.APIClient, constraints []api.StrategyConstraint) error {
+	if len(constraints) == 0 {
+		return nil
+	}
+
+	contextFields, _, err := client.ContextAPI.GetContextFields(ctx).Execute()
+	if err != nil {
+		return err
+	}
+	knownContextFields := append([]string{}, builtInContextFields...)
+	for _, contextField := range contextFields {
+		knownContextFields = append(knownContextFields, contextField.Name)
+	}
+
+	for _, constraint := range constraints {
+		if !contains(knownContextFields, constraint.ContextName) {
+			return fmt.Errorf("%w: %s", ErrUnknownContextField, constraint.ContextName)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/philips-labs/terraform-provider-unleash/utils"
+)
+
+func TestAccResourceContextField(t *testing.T) {
+	randomSuffix := utils.RandomString(4)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// Initial creation
+				Config: testAccResourceContextFieldInitial(randomSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_context_field.foo", "name", "region"+randomSuffix),
+					resource.TestCheckResourceAttr("unleash_context_field.foo", "description", "request region"),
+					resource.TestCheckResourceAttr("unleash_context_field.foo", "stickiness", "false"),
+					resource.TestCheckResourceAttr("unleash_context_field.foo", "legal_value.#", "1"),
+					resource.TestCheckResourceAttr("unleash_context_field.foo", "legal_value.0.value", "eu"),
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.0.strategy.0.constraint.0.context_name", "region"+randomSuffix),
+				),
+			},
+			{
+				// Update configuration
+				Config: testAccResourceContextFieldUpdated(randomSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updates took effect
+					resource.TestCheckResourceAttr("unleash_context_field.foo", "description", "region the request comes from"),
+					resource.TestCheckResourceAttr("unleash_context_field.foo", "stickiness", "true"),
+					resource.TestCheckResourceAttr("unleash_context_field.foo", "legal_value.#", "2"),
+					resource.TestCheckResourceAttr("unleash_context_field.foo", "legal_value.1.value", "us"),
+					resource.TestCheckResourceAttr("unleash_context_field.foo", "legal_value.1.description", "United States"),
+					// Verify unchanged attributes
+					resource.TestCheckResourceAttr("unleash_context_field.foo", "name", "region"+randomSuffix),
+				),
+			},
+			{
+				ResourceName:      "unleash_context_field.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceContextFieldInitial(suffix string) string {
+	return fmt.Sprintf(`
+resource "unleash_context_field" "foo" {
+  name        = "region%s"
+  description = "request region"
+
+  legal_value {
+    value = "eu"
+  }
+}
+resource "unleash_feature_v2" "foo" {
+  name               = "bar%s"
+  type               = "release"
+  project_id         = "default"
+  archive_on_destroy = false
+
+  environment {
+    name = "development"
+
+    strategy {
+      name = "default"
+      constraint {
+        context_name = unleash_context_field.foo.name
+        operator     = "IN"
+        values       = ["eu"]
+      }
+    }
+  }
+}`, suffix, suffix)
+}
+
+func testAccResourceContextFieldUpdated(suffix string) string {
+	return fmt.Sprintf(`
+resource "unleash_context_field" "foo" {
+  name        = "region%s"
+  description = "region the request comes from"
+  stickiness  = true
+
+  legal_value {
+    value = "eu"
+  }
+  legal_value {
+    value       = "us"
+    description = "United States"
+  }
+}`, suffix)
+}
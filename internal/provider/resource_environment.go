@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+
+	openapiclient "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceEnvironment() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Provides a resource for managing unleash environments.",
+
+		CreateContext: resourceEnvironmentCreate,
+		ReadContext:   resourceEnvironmentRead,
+		UpdateContext: resourceEnvironmentUpdate,
+		DeleteContext: resourceEnvironmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: defaultTimeouts(),
+
+		// The descriptions are used by the documentation generator and the language server.
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description:  "The environment name. Changing it forces a new resource to be created.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9~_.-]+$`), "must be a URL-friendly string"),
+			},
+			"type": {
+				Description: "The environment type. Unleash recognizes `development`, `test`, `preproduction` and `production`.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"sort_order": {
+				Description: "The position of the environment in the list of environments, lower numbers are shown first.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"enabled": {
+				Description: "Whether the environment is enabled. Default is `true`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"protected": {
+				Description: "Whether the environment is protected. Protected environments can not be deleted.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	enabled := d.Get("enabled").(bool)
+
+	createEnvironmentSchema := *openapiclient.NewCreateEnvironmentSchema(d.Get("name").(string), d.Get("type").(string))
+	createEnvironmentSchema.Enabled = &enabled
+	if v, ok := d.GetOk("sort_order"); ok {
+		sortOrder := int32(v.(int))
+		createEnvironmentSchema.SortOrder = &sortOrder
+	}
+
+	createdEnvironment, resp, err := client.EnvironmentsAPI.CreateEnvironment(ctx).CreateEnvironmentSchema(createEnvironmentSchema).Execute()
+	if resp == nil {
+		return diag.FromErr(fmt.Errorf("response is nil: %v", err))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdEnvironment.Name)
+	readDiags := resourceEnvironmentRead(ctx, d, meta)
+	if readDiags != nil {
+		diags = append(diags, readDiags...)
+	}
+
+	return diags
+}
+
+func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	environment, resp, err := client.EnvironmentsAPI.GetEnvironment(ctx, d.Id()).Execute()
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("name", environment.Name)
+	_ = d.Set("type", environment.Type)
+	_ = d.Set("sort_order", environment.SortOrder)
+	_ = d.Set("enabled", environment.Enabled)
+	_ = d.Set("protected", environment.Protected)
+
+	return diags
+}
+
+func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	name := d.Id()
+
+	if d.HasChanges("type", "sort_order") {
+		environmentType := d.Get("type").(string)
+		sortOrder := int32(d.Get("sort_order").(int))
+
+		updateEnvironmentSchema := *openapiclient.NewUpdateEnvironmentSchema()
+		updateEnvironmentSchema.Type = &environmentType
+		updateEnvironmentSchema.SortOrder = &sortOrder
+
+		_, resp, err := client.EnvironmentsAPI.UpdateEnvironment(ctx, name).UpdateEnvironmentSchema(updateEnvironmentSchema).Execute()
+		if resp == nil {
+			return diag.FromErr(fmt.Errorf("response is nil: %v", err))
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("enabled") {
+		toggle := "off"
+		if d.Get("enabled").(bool) {
+			toggle = "on"
+		}
+		_, err := adminRequest(ctx, client, http.MethodPost, "/api/admin/environments/"+url.PathEscape(name)+"/"+toggle, nil, nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	readDiags := resourceEnvironmentRead(ctx, d, meta)
+	if readDiags != nil {
+		diags = append(diags, readDiags...)
+	}
+
+	return diags
+}
+
+func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	_, err := client.EnvironmentsAPI.RemoveEnvironment(ctx, d.Id()).Execute()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return diags
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/philips-labs/terraform-provider-unleash/utils"
+)
+
+func TestAccResourceEnvironment(t *testing.T) {
+	randomSuffix := utils.RandomString(4)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// Initial creation
+				Config: testAccResourceEnvironmentInitial(randomSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("unleash_environment.foo", "name", regexp.MustCompile("^bar")),
+					resource.TestCheckResourceAttr("unleash_environment.foo", "type", "test"),
+					resource.TestCheckResourceAttr("unleash_environment.foo", "sort_order", "5"),
+					resource.TestCheckResourceAttr("unleash_environment.foo", "enabled", "true"),
+					resource.TestCheckResourceAttr("unleash_environment.foo", "protected", "false"),
+				),
+			},
+			{
+				// Update configuration
+				Config: testAccResourceEnvironmentUpdated(randomSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updates took effect
+					resource.TestCheckResourceAttr("unleash_environment.foo", "type", "preproduction"),
+					resource.TestCheckResourceAttr("unleash_environment.foo", "sort_order", "7"),
+					resource.TestCheckResourceAttr("unleash_environment.foo", "enabled", "false"),
+					// Verify unchanged attributes
+					resource.TestMatchResourceAttr("unleash_environment.foo", "name", regexp.MustCompile("^bar")),
+				),
+			},
+			{
+				ResourceName:      "unleash_environment.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceEnvironmentInitial(suffix string) string {
+	return fmt.Sprintf(`
+resource "unleash_environment" "foo" {
+  name       = "bar%s"
+  type       = "test"
+  sort_order = 5
+}`, suffix)
+}
+
+func testAccResourceEnvironmentUpdated(suffix string) string {
+	return fmt.Sprintf(`
+resource "unleash_environment" "foo" {
+  name       = "bar%s"
+  type       = "preproduction"
+  sort_order = 7
+  enabled    = false
+}`, suffix)
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -19,6 +20,12 @@ func resourceFeature() *schema.Resource {
 		UpdateContext: resourceFeatureUpdate,
 		DeleteContext: resourceFeatureDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceFeatureImport,
+		},
+
+		Timeouts: defaultTimeouts(),
+
 		// The descriptions are used by the documentation generator and the language server.
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -44,7 +51,7 @@ func resourceFeature() *schema.Resource {
 				Optional:    true,
 			},
 			"archive_on_destroy": {
-				Description: "Whether to archive the feature toggle on destroy. Default is `true`. When `false`, it will permanently delete the feature toggle.",
+				Description: "Whether to archive the feature toggle on destroy. Default is `true`. When `false`, it will permanently delete the feature toggle. The feature can not be destroyed while other features depend on it.",
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     true,
@@ -54,7 +61,7 @@ func resourceFeature() *schema.Resource {
 }
 
 func resourceFeatureCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*ApiClients).PhilipsUnleashClient
+	client := meta.(*ApiClients).philipsClient(ctx)
 
 	var diags diag.Diagnostics
 
@@ -83,7 +90,7 @@ func resourceFeatureCreate(ctx context.Context, d *schema.ResourceData, meta int
 }
 
 func resourceFeatureRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*ApiClients).PhilipsUnleashClient
+	client := meta.(*ApiClients).philipsClient(ctx)
 
 	var diags diag.Diagnostics
 
@@ -106,7 +113,7 @@ func resourceFeatureRead(ctx context.Context, d *schema.ResourceData, meta inter
 }
 
 func resourceFeatureUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*ApiClients).PhilipsUnleashClient
+	client := meta.(*ApiClients).philipsClient(ctx)
 
 	var diags diag.Diagnostics
 
@@ -130,12 +137,15 @@ func resourceFeatureUpdate(ctx context.Context, d *schema.ResourceData, meta int
 
 // Archives a feature
 func resourceFeatureDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*ApiClients).PhilipsUnleashClient
+	client := meta.(*ApiClients).philipsClient(ctx)
 
 	var diags diag.Diagnostics
 
 	featureName := d.Id()
 	projectId := d.Get("project_id").(string)
+	if err := checkFeatureChildren(ctx, meta.(*ApiClients).UnleashClient, projectId, featureName); err != nil {
+		return diag.FromErr(err)
+	}
 	_, _, err := client.FeatureToggles.ArchiveFeature(projectId, featureName)
 	if err != nil {
 		return diag.FromErr(err)
@@ -151,6 +161,32 @@ func resourceFeatureDelete(ctx context.Context, d *schema.ResourceData, meta int
 	return diags
 }
 
+func resourceFeatureImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := parseImportId(d.Id(), "project/feature")
+	if err != nil {
+		return nil, err
+	}
+	_ = d.Set("project_id", parts[0])
+	_ = d.Set("archive_on_destroy", resourceFeature().Schema["archive_on_destroy"].Default)
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, nil
+}
+
+// parseImportId splits an import ID in as many parts as the given format has, e.g. "project/feature".
+func parseImportId(id string, format string) ([]string, error) {
+	count := len(strings.Split(format, "/"))
+	parts := strings.SplitN(id, "/", count)
+	if len(parts) != count {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected %s", id, format)
+	}
+	for _, part := range parts {
+		if part == "" {
+			return nil, fmt.Errorf("unexpected format of ID (%s), expected %s", id, format)
+		}
+	}
+	return parts, nil
+}
+
 func toStringArray(iArr []interface{}) []string {
 	stringArr := make([]string, len(iArr))
 	for i, v := range iArr {
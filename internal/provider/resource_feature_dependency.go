@@ -0,0 +1,231 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	openapiclient "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type featureDependency struct {
+	Feature  string   `json:"feature"`
+	Enabled  bool     `json:"enabled"`
+	Variants []string `json:"variants"`
+}
+
+// featureDependencies holds the dependency related fields of a feature.
+type featureDependencies struct {
+	Dependencies []featureDependency `json:"dependencies"`
+	Children     []string            `json:"children"`
+}
+
+func resourceFeatureDependency() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Provides a resource for making a feature depend on a parent feature. The child feature is only evaluated when the parent is enabled.",
+
+		CreateContext: resourceFeatureDependencyCreate,
+		ReadContext:   resourceFeatureDependencyRead,
+		UpdateContext: resourceFeatureDependencyUpdate,
+		DeleteContext: resourceFeatureDependencyDelete,
+
+		CustomizeDiff: requireServerDiff("unleash_feature_dependency", 5, 7),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceFeatureDependencyImport,
+		},
+
+		Timeouts: defaultTimeouts(),
+
+		// The descriptions are used by the documentation generator and the language server.
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Description: "The project of the child feature. Changing it forces a new resource to be created.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"feature_name": {
+				Description: "The child feature name. Changing it forces a new resource to be created.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"parent": {
+				Description: "The parent feature name. It must be in the same project as the child feature. Changing it forces a new resource to be created.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"enabled": {
+				Description: "Whether the parent feature must be enabled (`true`) or disabled (`false`) for the child feature to be evaluated. Default is `true`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"variants": {
+				Description: "The parent variants the child feature depends on. Only for dependencies on an enabled parent. When empty, any variant of the parent is accepted.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceFeatureDependencyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	projectId := d.Get("project_id").(string)
+	featureName := d.Get("feature_name").(string)
+
+	resp, err := adminRequest(ctx, client, http.MethodPost, featureDependenciesPath(projectId, featureName), toFeatureDependency(d), nil)
+	if resp == nil {
+		return diag.FromErr(fmt.Errorf("response is nil: %v", err))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(projectId + "/" + featureName + "/" + d.Get("parent").(string))
+	readDiags := resourceFeatureDependencyRead(ctx, d, meta)
+	if readDiags != nil {
+		diags = append(diags, readDiags...)
+	}
+
+	return diags
+}
+
+func resourceFeatureDependencyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	parts, err := parseImportId(d.Id(), "project/feature/parent")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	projectId, featureName, parent := parts[0], parts[1], parts[2]
+
+	feature, resp, err := getFeatureDependencies(ctx, client, projectId, featureName)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	var foundDependency *featureDependency
+	for i, dependency := range feature.Dependencies {
+		if dependency.Feature == parent {
+			foundDependency = &feature.Dependencies[i]
+			break
+		}
+	}
+	if foundDependency == nil {
+		d.SetId("")
+		return diags
+	}
+
+	_ = d.Set("project_id", projectId)
+	_ = d.Set("feature_name", featureName)
+	_ = d.Set("parent", foundDependency.Feature)
+	_ = d.Set("enabled", foundDependency.Enabled)
+	_ = d.Set("variants", foundDependency.Variants)
+
+	return diags
+}
+
+func resourceFeatureDependencyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	// Adding a dependency on the same parent replaces the existing one
+	resp, err := adminRequest(ctx, client, http.MethodPost, featureDependenciesPath(d.Get("project_id").(string), d.Get("feature_name").(string)), toFeatureDependency(d), nil)
+	if resp == nil {
+		return diag.FromErr(fmt.Errorf("response is nil: %v", err))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	readDiags := resourceFeatureDependencyRead(ctx, d, meta)
+	if readDiags != nil {
+		diags = append(diags, readDiags...)
+	}
+
+	return diags
+}
+
+func resourceFeatureDependencyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	path := featureDependenciesPath(d.Get("project_id").(string), d.Get("feature_name").(string)) + "/" + url.PathEscape(d.Get("parent").(string))
+	_, err := adminRequest(ctx, client, http.MethodDelete, path, nil, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return diags
+}
+
+func resourceFeatureDependencyImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, err := parseImportId(d.Id(), "project/feature/parent"); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func toFeatureDependency(d *schema.ResourceData) featureDependency {
+	dependency := featureDependency{
+		Feature:  d.Get("parent").(string),
+		Enabled:  d.Get("enabled").(bool),
+		Variants: []string{},
+	}
+	for _, variant := range d.Get("variants").([]interface{}) {
+		dependency.Variants = append(dependency.Variants, variant.(string))
+	}
+	return dependency
+}
+
+func featureDependenciesPath(projectId string, featureName string) string {
+	return "/api/admin/projects/" + url.PathEscape(projectId) + "/features/" + url.PathEscape(featureName) + "/dependencies"
+}
+
+func getFeatureDependencies(ctx context.Context, client *openapiclient.APIClient, projectId string, featureName string) (*featureDependencies, *http.Response, error) {
+	var feature featureDependencies
+	resp, err := adminRequest(ctx, client, http.MethodGet, "/api/admin/projects/"+url.PathEscape(projectId)+"/features/"+url.PathEscape(featureName), nil, &feature)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &feature, resp, nil
+}
+
+// checkFeatureChildren returns an error when other features still depend on the given feature,
+// as archiving it would leave them depending on a feature that no longer exists.
+func checkFeatureChildren(ctx context.Context, client *openapiclient.APIClient, projectId string, featureName string) error {
+	feature, resp, err := getFeatureDependencies(ctx, client, projectId, featureName)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return err
+	}
+	if len(feature.Children) > 0 {
+		return fmt.Errorf("%w: %s is the parent of %s", ErrFeatureHasChildren, featureName, strings.Join(feature.Children, ", "))
+	}
+	return nil
+}
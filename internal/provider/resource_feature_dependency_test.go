@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/philips-labs/terraform-provider-unleash/utils"
+)
+
+func TestAccResourceFeatureDependency(t *testing.T) {
+	randomSuffix := utils.RandomString(4)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// Initial creation
+				Config: testAccResourceFeatureDependencyInitial(randomSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_feature_dependency.foo", "project_id", "default"),
+					resource.TestCheckResourceAttr("unleash_feature_dependency.foo", "feature_name", "child"+randomSuffix),
+					resource.TestCheckResourceAttr("unleash_feature_dependency.foo", "parent", "parent"+randomSuffix),
+					resource.TestCheckResourceAttr("unleash_feature_dependency.foo", "enabled", "true"),
+					resource.TestCheckResourceAttr("unleash_feature_dependency.foo", "variants.#", "0"),
+				),
+			},
+			{
+				// Update configuration
+				Config: testAccResourceFeatureDependencyUpdated(randomSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updates took effect
+					resource.TestCheckResourceAttr("unleash_feature_dependency.foo", "enabled", "false"),
+					// Verify unchanged attributes
+					resource.TestCheckResourceAttr("unleash_feature_dependency.foo", "parent", "parent"+randomSuffix),
+				),
+			},
+			{
+				ResourceName:      "unleash_feature_dependency.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceFeatureDependencyFeatures(suffix string) string {
+	return fmt.Sprintf(`
+resource "unleash_feature_v2" "parent" {
+  name               = "parent%s"
+  type               = "release"
+  project_id         = "default"
+  archive_on_destroy = false
+}
+resource "unleash_feature_v2" "child" {
+  name               = "child%s"
+  type               = "release"
+  project_id         = "default"
+  archive_on_destroy = false
+}`, suffix, suffix)
+}
+
+func testAccResourceFeatureDependencyInitial(suffix string) string {
+	return testAccResourceFeatureDependencyFeatures(suffix) + `
+resource "unleash_feature_dependency" "foo" {
+  project_id   = "default"
+  feature_name = unleash_feature_v2.child.name
+  parent       = unleash_feature_v2.parent.name
+}`
+}
+
+func testAccResourceFeatureDependencyUpdated(suffix string) string {
+	return testAccResourceFeatureDependencyFeatures(suffix) + `
+resource "unleash_feature_dependency" "foo" {
+  project_id   = "default"
+  feature_name = unleash_feature_v2.child.name
+  parent       = unleash_feature_v2.parent.name
+  enabled      = false
+}`
+}
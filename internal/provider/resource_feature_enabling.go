@@ -18,6 +18,12 @@ func resourceFeatureEnabling() *schema.Resource {
 		UpdateContext: resourceFeatureEnablingUpdate,
 		DeleteContext: resourceFeatureEnablingDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceFeatureEnablingImport,
+		},
+
+		Timeouts: defaultTimeouts(),
+
 		// The descriptions are used by the documentation generator and the language server.
 		Schema: map[string]*schema.Schema{
 			"feature_name": {
@@ -49,7 +55,7 @@ func resourceFeatureEnabling() *schema.Resource {
 }
 
 func resourceFeatureEnablingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*ApiClients).PhilipsUnleashClient
+	client := meta.(*ApiClients).philipsClient(ctx)
 
 	var diags diag.Diagnostics
 
@@ -58,9 +64,9 @@ func resourceFeatureEnablingCreate(ctx context.Context, d *schema.ResourceData,
 	environment := d.Get("environment").(string)
 	enabled := d.Get("enabled").(bool)
 
-	ok, _, err := client.FeatureToggles.EnableFeatureOnEnvironment(projectId, featureName, environment, enabled)
+	ok, resp, err := client.FeatureToggles.EnableFeatureOnEnvironment(projectId, featureName, environment, enabled)
 	if err != nil || !ok {
-		return diag.FromErr(err)
+		return environmentWriteDiags(projectId, environment, httpResponse(resp), err)
 	}
 
 	d.SetId(featureName + "/" + environment)
@@ -73,7 +79,7 @@ func resourceFeatureEnablingCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceFeatureEnablingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*ApiClients).PhilipsUnleashClient
+	client := meta.(*ApiClients).philipsClient(ctx)
 
 	var diags diag.Diagnostics
 
@@ -101,8 +107,20 @@ func resourceFeatureEnablingRead(ctx context.Context, d *schema.ResourceData, me
 	return diags
 }
 
+func resourceFeatureEnablingImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := parseImportId(d.Id(), "project/feature/environment")
+	if err != nil {
+		return nil, err
+	}
+	_ = d.Set("project_id", parts[0])
+	_ = d.Set("feature_name", parts[1])
+	_ = d.Set("environment", parts[2])
+	d.SetId(parts[1] + "/" + parts[2])
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceFeatureEnablingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*ApiClients).PhilipsUnleashClient
+	client := meta.(*ApiClients).philipsClient(ctx)
 
 	var diags diag.Diagnostics
 
@@ -111,16 +129,16 @@ func resourceFeatureEnablingUpdate(ctx context.Context, d *schema.ResourceData,
 	environment := d.Get("environment").(string)
 	enabled := d.Get("enabled").(bool)
 
-	ok, _, err := client.FeatureToggles.EnableFeatureOnEnvironment(projectId, featureName, environment, enabled)
+	ok, resp, err := client.FeatureToggles.EnableFeatureOnEnvironment(projectId, featureName, environment, enabled)
 	if err != nil || !ok {
-		return diag.FromErr(err)
+		return environmentWriteDiags(projectId, environment, httpResponse(resp), err)
 	}
 
 	return diags
 }
 
 func resourceFeatureEnablingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*ApiClients).PhilipsUnleashClient
+	client := meta.(*ApiClients).philipsClient(ctx)
 
 	var diags diag.Diagnostics
 
@@ -128,9 +146,9 @@ func resourceFeatureEnablingDelete(ctx context.Context, d *schema.ResourceData,
 	featureName := d.Get("feature_name").(string)
 	environment := d.Get("environment").(string)
 
-	_, _, err := client.FeatureToggles.EnableFeatureOnEnvironment(projectId, featureName, environment, false)
+	_, resp, err := client.FeatureToggles.EnableFeatureOnEnvironment(projectId, featureName, environment, false)
 	if err != nil {
-		return diag.FromErr(err)
+		return environmentWriteDiags(projectId, environment, httpResponse(resp), err)
 	}
 	d.SetId("")
 	return diags
@@ -23,6 +23,12 @@ func TestAccResourceFeatureEnabling(t *testing.T) {
 					resource.TestCheckResourceAttr("unleash_feature_enabling.foo", "enabled", "true"),
 				),
 			},
+			{
+				ResourceName:      "unleash_feature_enabling.foo",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIdFunc("unleash_feature_enabling.foo", "project_id", "feature_name", "environment"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
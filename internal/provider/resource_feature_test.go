@@ -22,6 +22,12 @@ func TestAccResourceFeature(t *testing.T) {
 					resource.TestCheckResourceAttr("unleash_feature.foo", "type", "release"),
 				),
 			},
+			{
+				ResourceName:      "unleash_feature.foo",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIdFunc("unleash_feature.foo", "project_id", "name"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -31,5 +37,10 @@ resource "unleash_feature" "foo" {
   name = "bar%s"
   project_id = "default"
   type = "release"
+
+  timeouts {
+    create = "2m"
+    read   = "1m"
+  }
 }
 `, utils.RandomString(4))
@@ -3,13 +3,42 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
 
+	openapiclient "github.com/Unleash/unleash-server-api-go/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/philips-labs/go-unleash-api/v2/api"
 )
 
+// featureEnvironmentVariant is a variant defined directly on a feature environment, rather than on one of its strategies.
+type featureEnvironmentVariant struct {
+	Name       string              `json:"name"`
+	Weight     int                 `json:"weight"`
+	WeightType string              `json:"weightType"`
+	Stickiness string              `json:"stickiness"`
+	Payload    *api.VariantPayload `json:"payload,omitempty"`
+	Overrides  []variantOverride   `json:"overrides"`
+}
+
+type variantOverride struct {
+	ContextName string   `json:"contextName"`
+	Values      []string `json:"values"`
+}
+
+// featureEnvironmentDetails is the part of a feature environment that the philips client does not read.
+type featureEnvironmentDetails struct {
+	Variants   []featureEnvironmentVariant `json:"variants"`
+	Strategies []struct {
+		Id       string `json:"id"`
+		Segments []int  `json:"segments"`
+	} `json:"strategies"`
+}
+
 func resourceFeatureV2() *schema.Resource {
 	return &schema.Resource{
 		// This description is used by the documentation generator and the language server.
@@ -20,6 +49,14 @@ func resourceFeatureV2() *schema.Resource {
 		UpdateContext: resourceFeatureV2Update,
 		DeleteContext: resourceFeatureV2Delete,
 
+		CustomizeDiff: resourceFeatureV2CustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceFeatureV2Import,
+		},
+
+		Timeouts: defaultTimeouts(),
+
 		// The descriptions are used by the documentation generator and the language server.
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -45,13 +82,13 @@ func resourceFeatureV2() *schema.Resource {
 				Optional:    true,
 			},
 			"archive_on_destroy": {
-				Description: "Whether to archive the feature toggle on destroy. Default is `true`. When `false`, it will permanently delete the feature toggle.",
+				Description: "Whether to archive the feature toggle on destroy. Default is `true`. When `false`, it will permanently delete the feature toggle. The feature can not be destroyed while other features depend on it.",
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     true,
 			},
 			"environment": {
-				Description: "Use this to enable a feature in an environment and add strategies",
+				Description: "Use this to enable a feature in an environment and add strategies. Importing a feature lists its environments sorted by name, so configure them in that order to import it without changes.",
 				Type:        schema.TypeList,
 				Optional:    true,
 				Elem: &schema.Resource{
@@ -138,50 +175,13 @@ func resourceFeatureV2() *schema.Resource {
 											},
 										},
 									},
-									"constraint": {
-										Description: "Strategy constraint",
-										Type:        schema.TypeList,
+									"constraint": constraintSchema(),
+									"segments": {
+										Description: "IDs of the segments the strategy uses",
+										Type:        schema.TypeSet,
 										Optional:    true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"context_name": {
-													Description:  "Constraint context. Can be `appName`, `currentTime`, `environment`, `sessionId` or `userId`",
-													Type:         schema.TypeString,
-													Required:     true,
-													ValidateFunc: validation.StringInSlice([]string{"appName", "currentTime", "environment", "sessionId", "userId"}, false),
-												},
-												"operator": {
-													Description:  "Constraint operator. Can be `IN`, `NOT_IN`, `STR_CONTAINS`, `STR_STARTS_WITH`, `STR_ENDS_WITH`, `NUM_EQ`, `NUM_GT`, `NUM_GTE`, `NUM_LT`, `NUM_LTE`, `SEMVER_EQ`, `SEMVER_GT` or `SEMVER_LT`",
-													Type:         schema.TypeString,
-													Required:     true,
-													ValidateFunc: validation.StringInSlice([]string{"IN", "NOT_IN", "STR_CONTAINS", "STR_STARTS_WITH", "STR_ENDS_WITH", "NUM_EQ", "NUM_GT", "NUM_GTE", "NUM_LT", "NUM_LTE", "SEMVER_EQ", "SEMVER_GT", "SEMVER_LT"}, false),
-												},
-												"value": {
-													Description: "Value to use in the evaluation of the constraint. Applies only to `DATE_`, `NUM_` and `SEMVER_` operators.",
-													Type:        schema.TypeString,
-													Optional:    true,
-												},
-												"values": {
-													Description: "List of values to use in the evaluation of the constraint. Applies to all operators, except `DATE_`, `NUM_` and `SEMVER_`.",
-													Type:        schema.TypeList,
-													Optional:    true,
-													Elem: &schema.Schema{
-														Type: schema.TypeString,
-													},
-												},
-												"case_insensitive": {
-													Description: "If operator is case-insensitive.",
-													Type:        schema.TypeBool,
-													Optional:    true,
-													Default:     false,
-												},
-												"inverted": {
-													Description: "If constraint expressions will be negated, meaning that they get their opposite value.",
-													Type:        schema.TypeBool,
-													Optional:    true,
-													Default:     false,
-												},
-											},
+										Elem: &schema.Schema{
+											Type: schema.TypeInt,
 										},
 									},
 									"id": {
@@ -192,6 +192,29 @@ func resourceFeatureV2() *schema.Resource {
 								},
 							},
 						},
+						"variant": environmentVariantSchema(),
+						"change_request": {
+							Description: "Whether to submit the strategy, variant and enablement changes to the environment as a change request instead of applying them directly. Change requests are only available in Unleash Enterprise. The environment can not be changed again while its change request is pending. Default is `false`.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+						"submit_change_request": {
+							Description: "Whether to submit the change request for review. When `false`, it is left as a draft. Only considered when `change_request` is `true`. Default is `true`.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+						},
+						"change_request_id": {
+							Description: "The id of the last change request created for the environment.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"change_request_state": {
+							Description: "The state of the last change request created for the environment, such as `Draft`, `In review`, `Approved` or `Applied`. While it is pending, the environment keeps the configured values.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
 					},
 				},
 			},
@@ -220,7 +243,7 @@ func resourceFeatureV2() *schema.Resource {
 }
 
 func resourceFeatureV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*ApiClients).PhilipsUnleashClient
+	client := meta.(*ApiClients).philipsClient(ctx)
 
 	var diags diag.Diagnostics
 
@@ -231,6 +254,12 @@ func resourceFeatureV2Create(ctx context.Context, d *schema.ResourceData, meta i
 		Project:     d.Get("project_id").(string),
 	}
 
+	err := validateConstraintContexts(ctx, meta.(*ApiClients).UnleashClient, featureConstraints(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Change requests only cover the environments of a feature, so its own settings are never rejected for them
 	createdFeature, resp, err := client.FeatureToggles.CreateFeature(feature.Project, *feature)
 	if resp == nil {
 		return diag.FromErr(fmt.Errorf("response is nil: %v", err))
@@ -243,23 +272,53 @@ func resourceFeatureV2Create(ctx context.Context, d *schema.ResourceData, meta i
 		tfEnvironments := e.([]interface{})
 		for _, tfEnvironment := range tfEnvironments {
 			environment := toFeatureEnvironment(tfEnvironment.(map[string]interface{}))
+			tfStrategies := tfEnvironment.(map[string]interface{})["strategy"].([]interface{})
 
-			for _, strategy := range environment.Strategies {
-				_, resp, err := client.FeatureToggles.AddStrategyToFeature(feature.Project, feature.Name, environment.Name, strategy)
-				if resp == nil || err != nil {
+			if tfEnvironment.(map[string]interface{})["change_request"].(bool) {
+				err := requestEnvironmentChanges(ctx, meta.(*ApiClients).UnleashClient, feature.Project, feature.Name, nil, tfEnvironment.(map[string]interface{}))
+				if err != nil {
 					client.FeatureToggles.ArchiveFeature(feature.Project, feature.Name)
 					client.FeatureToggles.DeleteArchivedFeature(feature.Name)
 					return diag.FromErr(err)
 				}
+				continue
+			}
 
+			for i, strategy := range environment.Strategies {
+				addedStrategy, resp, err := client.FeatureToggles.AddStrategyToFeature(feature.Project, feature.Name, environment.Name, strategy)
+				if resp == nil || err != nil {
+					client.FeatureToggles.ArchiveFeature(feature.Project, feature.Name)
+					client.FeatureToggles.DeleteArchivedFeature(feature.Name)
+					return environmentWriteDiags(feature.Project, environment.Name, httpResponse(resp), err)
+				}
+				segmentIds := toSegmentIds(tfStrategies[i].(map[string]interface{})["segments"].(*schema.Set))
+				if len(segmentIds) > 0 {
+					err := setStrategySegments(ctx, meta.(*ApiClients).UnleashClient, feature.Project, environment.Name, addedStrategy.ID, segmentIds)
+					if err != nil {
+						client.FeatureToggles.ArchiveFeature(feature.Project, feature.Name)
+						client.FeatureToggles.DeleteArchivedFeature(feature.Name)
+						return diag.FromErr(err)
+					}
+				}
+			}
+			variants := toEnvironmentVariants(tfEnvironment.(map[string]interface{}))
+			if len(variants) > 0 {
+				err := setEnvironmentVariants(ctx, meta.(*ApiClients).UnleashClient, feature.Project, feature.Name, environment.Name, variants)
+				if err != nil {
+					client.FeatureToggles.ArchiveFeature(feature.Project, feature.Name)
+					client.FeatureToggles.DeleteArchivedFeature(feature.Name)
+					return environmentWriteDiags(feature.Project, environment.Name, nil, err)
+				}
 			}
-			ok, _, err := client.FeatureToggles.EnableFeatureOnEnvironment(feature.Project, feature.Name, environment.Name, environment.Enabled)
+			ok, resp, err := client.FeatureToggles.EnableFeatureOnEnvironment(feature.Project, feature.Name, environment.Name, environment.Enabled)
 			if err != nil || !ok {
 				client.FeatureToggles.ArchiveFeature(feature.Project, feature.Name)
 				client.FeatureToggles.DeleteArchivedFeature(feature.Name)
-				return diag.FromErr(err)
+				return environmentWriteDiags(feature.Project, environment.Name, httpResponse(resp), err)
 			}
 		}
+		// Keeps the ids of the change requests for the read
+		_ = d.Set("environment", tfEnvironments)
 	}
 	if t, ok := d.GetOk("tag"); ok {
 		tfTags := t.([]interface{})
@@ -285,7 +344,7 @@ func resourceFeatureV2Create(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func resourceFeatureV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*ApiClients).PhilipsUnleashClient
+	client := meta.(*ApiClients).philipsClient(ctx)
 
 	var diags diag.Diagnostics
 
@@ -313,7 +372,16 @@ func resourceFeatureV2Read(ctx context.Context, d *schema.ResourceData, meta int
 				}
 			}
 		}
-		_ = d.Set("environment", flattenEnvironments(toSave))
+		tfEnvironments := flattenEnvironments(toSave)
+		err := readEnvironmentDetails(ctx, meta.(*ApiClients).UnleashClient, feature.Project, feature.Name, tfEnvironments)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		err = readEnvironmentChangeRequests(ctx, meta.(*ApiClients).UnleashClient, feature.Project, e.([]interface{}), tfEnvironments)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		_ = d.Set("environment", tfEnvironments)
 	}
 
 	if t, ok := d.GetOk("tag"); ok {
@@ -337,7 +405,7 @@ func resourceFeatureV2Read(ctx context.Context, d *schema.ResourceData, meta int
 }
 
 func resourceFeatureV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*ApiClients).PhilipsUnleashClient
+	client := meta.(*ApiClients).philipsClient(ctx)
 
 	var diags diag.Diagnostics
 
@@ -348,6 +416,12 @@ func resourceFeatureV2Update(ctx context.Context, d *schema.ResourceData, meta i
 		Project:     d.Get("project_id").(string),
 	}
 
+	err := validateConstraintContexts(ctx, meta.(*ApiClients).UnleashClient, featureConstraints(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Change requests only cover the environments of a feature, so its own settings are never rejected for them
 	_, resp, err := client.FeatureToggles.UpdateFeature(feature.Project, *feature)
 	if resp == nil {
 		return diag.FromErr(fmt.Errorf("response is nil: %v", err))
@@ -392,9 +466,17 @@ func resourceFeatureV2Update(ctx context.Context, d *schema.ResourceData, meta i
 		toAdd := []api.Environment{}
 		toUpdate := []api.Environment{}
 		toRemove := []api.Environment{}
+		newTfStrategies := map[string][]interface{}{}
+		newVariants := map[string][]featureEnvironmentVariant{}
+		oldVariants := map[string][]featureEnvironmentVariant{}
+		newTfEnvironments := map[string]map[string]interface{}{}
+		oldTfEnvironments := map[string]map[string]interface{}{}
 
 		for _, newEnv := range new {
 			newFeatureEnv := toFeatureEnvironment(newEnv.(map[string]interface{}))
+			newTfEnvironments[newFeatureEnv.Name] = newEnv.(map[string]interface{})
+			newTfStrategies[newFeatureEnv.Name] = newEnv.(map[string]interface{})["strategy"].([]interface{})
+			newVariants[newFeatureEnv.Name] = toEnvironmentVariants(newEnv.(map[string]interface{}))
 			if isEnvIn(newFeatureEnv.Name, old) {
 				toUpdate = append(toUpdate, newFeatureEnv)
 			} else {
@@ -406,12 +488,21 @@ func resourceFeatureV2Update(ctx context.Context, d *schema.ResourceData, meta i
 		for _, oldEnv := range old {
 			oldFeatureEnv := toFeatureEnvironment(oldEnv.(map[string]interface{}))
 			oldEnvs = append(oldEnvs, oldFeatureEnv)
+			oldVariants[oldFeatureEnv.Name] = toEnvironmentVariants(oldEnv.(map[string]interface{}))
+			oldTfEnvironments[oldFeatureEnv.Name] = oldEnv.(map[string]interface{})
 			if !isEnvIn(oldFeatureEnv.Name, new) {
 				toRemove = append(toRemove, oldFeatureEnv)
 			}
 		}
 
 		for _, envToUpdate := range toUpdate {
+			if newTfEnvironments[envToUpdate.Name]["change_request"].(bool) {
+				err := requestEnvironmentChanges(ctx, meta.(*ApiClients).UnleashClient, feature.Project, feature.Name, oldTfEnvironments[envToUpdate.Name], newTfEnvironments[envToUpdate.Name])
+				if err != nil {
+					return diag.FromErr(err)
+				}
+				continue
+			}
 			newStrats := envToUpdate.Strategies
 			oldStrats := []api.FeatureStrategy{}
 			for _, oldEnv := range oldEnvs {
@@ -419,70 +510,127 @@ func resourceFeatureV2Update(ctx context.Context, d *schema.ResourceData, meta i
 					oldStrats = oldEnv.Strategies
 				}
 			}
-			for _, newStrat := range newStrats {
+			for i, newStrat := range newStrats {
+				segments := newTfStrategies[envToUpdate.Name][i].(map[string]interface{})["segments"].(*schema.Set)
+				segmentIds := toSegmentIds(segments)
 				if isStratIn(newStrat.ID, oldStrats) {
 					_, resp, err := client.FeatureToggles.UpdateFeatureStrategy(feature.Project, feature.Name, envToUpdate.Name, newStrat)
+					if err != nil {
+						return environmentWriteDiags(feature.Project, envToUpdate.Name, httpResponse(resp), err)
+					}
 					if resp == nil {
-						return diag.FromErr(fmt.Errorf("response is nil: %v", err))
+						return diag.FromErr(fmt.Errorf("response is nil"))
 					}
-					if err != nil {
-						return diag.FromErr(err)
+					if strategySegmentsChanged(oldTfEnvironments[envToUpdate.Name], newStrat.ID, segments) {
+						err = setStrategySegments(ctx, meta.(*ApiClients).UnleashClient, feature.Project, envToUpdate.Name, newStrat.ID, segmentIds)
+						if err != nil {
+							return diag.FromErr(err)
+						}
 					}
 				} else {
-					_, resp, err := client.FeatureToggles.AddStrategyToFeature(feature.Project, feature.Name, envToUpdate.Name, newStrat)
+					addedStrategy, resp, err := client.FeatureToggles.AddStrategyToFeature(feature.Project, feature.Name, envToUpdate.Name, newStrat)
+					if err != nil {
+						return environmentWriteDiags(feature.Project, envToUpdate.Name, httpResponse(resp), err)
+					}
 					if resp == nil {
-						return diag.FromErr(fmt.Errorf("response is nil: %v", err))
+						return diag.FromErr(fmt.Errorf("response is nil"))
 					}
-					if err != nil {
-						return diag.FromErr(err)
+					if len(segmentIds) > 0 {
+						err = setStrategySegments(ctx, meta.(*ApiClients).UnleashClient, feature.Project, envToUpdate.Name, addedStrategy.ID, segmentIds)
+						if err != nil {
+							return diag.FromErr(err)
+						}
 					}
 				}
 			}
 
 			for _, oldStrat := range oldStrats {
 				if !isStratIn(oldStrat.ID, newStrats) {
-					_, _, err = client.FeatureToggles.DeleteStrategyFromFeature(feature.Project, feature.Name, envToUpdate.Name, oldStrat.ID)
+					_, resp, err := client.FeatureToggles.DeleteStrategyFromFeature(feature.Project, feature.Name, envToUpdate.Name, oldStrat.ID)
 					if err != nil {
-						return diag.FromErr(err)
+						return environmentWriteDiags(feature.Project, envToUpdate.Name, httpResponse(resp), err)
 					}
 				}
 			}
 
-			ok, _, err := client.FeatureToggles.EnableFeatureOnEnvironment(feature.Project, feature.Name, envToUpdate.Name, envToUpdate.Enabled)
+			if !reflect.DeepEqual(oldVariants[envToUpdate.Name], newVariants[envToUpdate.Name]) {
+				err = setEnvironmentVariants(ctx, meta.(*ApiClients).UnleashClient, feature.Project, feature.Name, envToUpdate.Name, newVariants[envToUpdate.Name])
+				if err != nil {
+					return environmentWriteDiags(feature.Project, envToUpdate.Name, nil, err)
+				}
+			}
+
+			ok, resp, err := client.FeatureToggles.EnableFeatureOnEnvironment(feature.Project, feature.Name, envToUpdate.Name, envToUpdate.Enabled)
 			if err != nil || !ok {
-				return diag.FromErr(err)
+				return environmentWriteDiags(feature.Project, envToUpdate.Name, httpResponse(resp), err)
 			}
 		}
 
 		for _, envToRemove := range toRemove {
-			for _, strategy := range envToRemove.Strategies {
-				_, _, err = client.FeatureToggles.DeleteStrategyFromFeature(feature.Project, feature.Name, envToRemove.Name, strategy.ID)
+			if oldTfEnvironments[envToRemove.Name]["change_request"].(bool) {
+				err := requestEnvironmentChanges(ctx, meta.(*ApiClients).UnleashClient, feature.Project, feature.Name, oldTfEnvironments[envToRemove.Name], nil)
 				if err != nil {
 					return diag.FromErr(err)
 				}
+				continue
+			}
+			for _, strategy := range envToRemove.Strategies {
+				_, resp, err := client.FeatureToggles.DeleteStrategyFromFeature(feature.Project, feature.Name, envToRemove.Name, strategy.ID)
+				if err != nil {
+					return environmentWriteDiags(feature.Project, envToRemove.Name, httpResponse(resp), err)
+				}
+			}
+			if len(oldVariants[envToRemove.Name]) > 0 {
+				err = setEnvironmentVariants(ctx, meta.(*ApiClients).UnleashClient, feature.Project, feature.Name, envToRemove.Name, []featureEnvironmentVariant{})
+				if err != nil {
+					return environmentWriteDiags(feature.Project, envToRemove.Name, nil, err)
+				}
 			}
-			ok, _, err := client.FeatureToggles.EnableFeatureOnEnvironment(feature.Project, feature.Name, envToRemove.Name, false)
+			ok, resp, err := client.FeatureToggles.EnableFeatureOnEnvironment(feature.Project, feature.Name, envToRemove.Name, false)
 			if err != nil || !ok {
-				return diag.FromErr(err)
+				return environmentWriteDiags(feature.Project, envToRemove.Name, httpResponse(resp), err)
 			}
 		}
 
 		for _, envToAdd := range toAdd {
-			for _, strategy := range envToAdd.Strategies {
-				_, resp, err := client.FeatureToggles.AddStrategyToFeature(feature.Project, feature.Name, envToAdd.Name, strategy)
+			if newTfEnvironments[envToAdd.Name]["change_request"].(bool) {
+				err := requestEnvironmentChanges(ctx, meta.(*ApiClients).UnleashClient, feature.Project, feature.Name, nil, newTfEnvironments[envToAdd.Name])
+				if err != nil {
+					return diag.FromErr(err)
+				}
+				continue
+			}
+			for i, strategy := range envToAdd.Strategies {
+				addedStrategy, resp, err := client.FeatureToggles.AddStrategyToFeature(feature.Project, feature.Name, envToAdd.Name, strategy)
+				if err != nil {
+					return environmentWriteDiags(feature.Project, envToAdd.Name, httpResponse(resp), err)
+				}
 				if resp == nil {
-					return diag.FromErr(fmt.Errorf("response is nil: %v", err))
+					return diag.FromErr(fmt.Errorf("response is nil"))
 				}
+				segmentIds := toSegmentIds(newTfStrategies[envToAdd.Name][i].(map[string]interface{})["segments"].(*schema.Set))
+				if len(segmentIds) > 0 {
+					err = setStrategySegments(ctx, meta.(*ApiClients).UnleashClient, feature.Project, envToAdd.Name, addedStrategy.ID, segmentIds)
+					if err != nil {
+						return diag.FromErr(err)
+					}
+				}
+			}
+			if len(newVariants[envToAdd.Name]) > 0 {
+				err = setEnvironmentVariants(ctx, meta.(*ApiClients).UnleashClient, feature.Project, feature.Name, envToAdd.Name, newVariants[envToAdd.Name])
 				if err != nil {
-					return diag.FromErr(err)
+					return environmentWriteDiags(feature.Project, envToAdd.Name, nil, err)
 				}
 			}
-			ok, _, err := client.FeatureToggles.EnableFeatureOnEnvironment(feature.Project, feature.Name, envToAdd.Name, envToAdd.Enabled)
+			ok, resp, err := client.FeatureToggles.EnableFeatureOnEnvironment(feature.Project, feature.Name, envToAdd.Name, envToAdd.Enabled)
 			if err != nil || !ok {
-				return diag.FromErr(err)
+				return environmentWriteDiags(feature.Project, envToAdd.Name, httpResponse(resp), err)
 			}
 		}
 
+		// Keeps the ids of the change requests for the read
+		_ = d.Set("environment", new)
+
 		readDiags := resourceFeatureV2Read(ctx, d, meta)
 		if readDiags != nil {
 			diags = append(diags, readDiags...)
@@ -523,12 +671,15 @@ func isStratIn(id string, strats []api.FeatureStrategy) bool {
 
 // Archives a feature
 func resourceFeatureV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*ApiClients).PhilipsUnleashClient
+	client := meta.(*ApiClients).philipsClient(ctx)
 
 	var diags diag.Diagnostics
 
 	featureName := d.Id()
 	projectId := d.Get("project_id").(string)
+	if err := checkFeatureChildren(ctx, meta.(*ApiClients).UnleashClient, projectId, featureName); err != nil {
+		return diag.FromErr(err)
+	}
 	_, _, err := client.FeatureToggles.ArchiveFeature(projectId, featureName)
 	if err != nil {
 		return diag.FromErr(err)
@@ -544,6 +695,91 @@ func resourceFeatureV2Delete(ctx context.Context, d *schema.ResourceData, meta i
 	return diags
 }
 
+// resourceFeatureV2CustomizeDiff fails the plan when an environment submits its changes as change requests to an instance
+// that does not support them.
+func resourceFeatureV2CustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, tfEnvironment := range d.Get("environment").([]interface{}) {
+		if tfEnvironment.(map[string]interface{})["change_request"].(bool) {
+			if err := serverInfo(meta).requireEdition("The change_request of unleash_feature_v2 environments", editionEnterprise); err != nil {
+				return err
+			}
+			break
+		}
+	}
+	return checkPendingChangeRequests(d)
+}
+
+// checkPendingChangeRequests fails the plan when it changes an environment whose change request is still pending. The
+// strategies of a pending change request have no id yet, so the new changes could not be told apart from the pending
+// ones and the strategies would end up added twice.
+func checkPendingChangeRequests(d *schema.ResourceDiff) error {
+	if d.Id() == "" || !d.HasChange("environment") {
+		return nil
+	}
+	old, new := d.GetChange("environment")
+	for _, oldEnv := range old.([]interface{}) {
+		oldTfEnvironment := oldEnv.(map[string]interface{})
+		if !oldTfEnvironment["change_request"].(bool) || !contains(pendingChangeRequestStates, oldTfEnvironment["change_request_state"].(string)) {
+			continue
+		}
+		var newTfEnvironment map[string]interface{}
+		for _, newEnv := range new.([]interface{}) {
+			if newEnv.(map[string]interface{})["name"] == oldTfEnvironment["name"] {
+				newTfEnvironment = newEnv.(map[string]interface{})
+			}
+		}
+		if newTfEnvironment == nil || len(environmentChanges(d.Get("name").(string), oldTfEnvironment, newTfEnvironment)) > 0 {
+			return fmt.Errorf("%w: change request %d of the %s environment is %s, apply or reject it in unleash before changing the environment again",
+				ErrChangeRequestPending, oldTfEnvironment["change_request_id"].(int), oldTfEnvironment["name"], oldTfEnvironment["change_request_state"])
+		}
+	}
+	return nil
+}
+
+func resourceFeatureV2Import(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*ApiClients).philipsClient(ctx)
+
+	parts, err := parseImportId(d.Id(), "project/feature")
+	if err != nil {
+		return nil, err
+	}
+	projectId, featureName := parts[0], parts[1]
+
+	feature, _, err := client.FeatureToggles.GetFeatureByName(projectId, featureName)
+	if err != nil {
+		return nil, err
+	}
+	featureTags, _, err := client.FeatureTags.GetAllFeatureTags(featureName)
+	if err != nil {
+		return nil, err
+	}
+
+	// Read only refreshes the environments and tags already in the state, so seed them with
+	// everything that is configured on the server. Untouched environments are left out, and the
+	// others are sorted by name, as the order they are configured in is not known.
+	tfEnvironments := flattenEnvironments(feature.Environments)
+	err = readEnvironmentDetails(ctx, meta.(*ApiClients).UnleashClient, projectId, featureName, tfEnvironments)
+	if err != nil {
+		return nil, err
+	}
+	environments := []interface{}{}
+	for i, env := range feature.Environments {
+		if env.Enabled || len(env.Strategies) > 0 || len(tfEnvironments[i].(map[string]interface{})["variant"].([]interface{})) > 0 {
+			environments = append(environments, tfEnvironments[i])
+		}
+	}
+	sort.SliceStable(environments, func(i, j int) bool {
+		return environments[i].(map[string]interface{})["name"].(string) < environments[j].(map[string]interface{})["name"].(string)
+	})
+
+	_ = d.Set("project_id", projectId)
+	_ = d.Set("archive_on_destroy", resourceFeatureV2().Schema["archive_on_destroy"].Default)
+	_ = d.Set("environment", environments)
+	_ = d.Set("tag", flattenTags(featureTags.Tags))
+	d.SetId(featureName)
+	return []*schema.ResourceData{d}, nil
+}
+
 func toFeatureEnvironment(tfEnvironment map[string]interface{}) api.Environment {
 	environment := api.Environment{}
 	environment.Name = tfEnvironment["name"].(string)
@@ -571,20 +807,7 @@ func toFeatureEnvironment(tfEnvironment map[string]interface{}) api.Environment
 					strategy.Parameters = castedParameters
 				}
 				if tfConstraints, ok := strategyMap["constraint"].([]interface{}); ok && len(tfConstraints) > 0 {
-					constraints := make([]api.StrategyConstraint, 0, len(tfConstraints))
-					for _, tfConstraint := range tfConstraints {
-						constraintMap := tfConstraint.(map[string]interface{})
-						constraint := api.StrategyConstraint{
-							ContextName:     constraintMap["context_name"].(string),
-							Operator:        constraintMap["operator"].(string),
-							Value:           constraintMap["value"].(string),
-							Values:          toStringArray(constraintMap["values"].([]interface{})),
-							Inverted:        constraintMap["inverted"].(bool),
-							CaseInsensitive: constraintMap["case_insensitive"].(bool),
-						}
-						constraints = append(constraints, constraint)
-					}
-					strategy.Constraints = constraints
+					strategy.Constraints = toStrategyConstraints(tfConstraints)
 				}
 				if tfVariants, ok := strategyMap["variant"].([]interface{}); ok && len(tfVariants) > 0 {
 					variants := make([]api.Variant, 0, len(tfVariants))
@@ -601,6 +824,17 @@ func toFeatureEnvironment(tfEnvironment map[string]interface{}) api.Environment
 	return environment
 }
 
+func featureConstraints(d *schema.ResourceData) []api.StrategyConstraint {
+	constraints := []api.StrategyConstraint{}
+	for _, tfEnvironment := range d.Get("environment").([]interface{}) {
+		environment := toFeatureEnvironment(tfEnvironment.(map[string]interface{}))
+		for _, strategy := range environment.Strategies {
+			constraints = append(constraints, strategy.Constraints...)
+		}
+	}
+	return constraints
+}
+
 func flattenEnvironments(environments []api.Environment) []interface{} {
 	if environments == nil {
 		return []interface{}{}
@@ -626,18 +860,7 @@ func flattenEnvironments(environments []api.Environment) []interface{} {
 				}
 				tfStrategy["parameters"] = castedParams
 				if strategy.Constraints != nil {
-					tfConstraints := []interface{}{}
-					for _, constraint := range strategy.Constraints {
-						tfConstraint := map[string]interface{}{}
-						tfConstraint["context_name"] = constraint.ContextName
-						tfConstraint["operator"] = constraint.Operator
-						tfConstraint["value"] = constraint.Value
-						tfConstraint["values"] = constraint.Values
-						tfConstraint["inverted"] = constraint.Inverted
-						tfConstraint["case_insensitive"] = constraint.CaseInsensitive
-						tfConstraints = append(tfConstraints, tfConstraint)
-					}
-					tfStrategy["constraint"] = tfConstraints
+					tfStrategy["constraint"] = flattenConstraints(strategy.Constraints)
 				}
 				if strategy.Variants != nil {
 					tfVariants := []interface{}{}
@@ -669,6 +892,256 @@ func flattenEnvironments(environments []api.Environment) []interface{} {
 	return tfEnvironments
 }
 
+// readEnvironmentDetails fills the variants of the flattened environments and the segments of their strategies, with one
+// request per environment.
+func readEnvironmentDetails(ctx context.Context, client *openapiclient.APIClient, projectId string, featureName string, tfEnvironments []interface{}) error {
+	for _, tfEnvironment := range tfEnvironments {
+		environmentMap := tfEnvironment.(map[string]interface{})
+		var found featureEnvironmentDetails
+		_, err := adminRequest(ctx, client, http.MethodGet, featureEnvironmentPath(projectId, featureName, environmentMap["name"].(string)), nil, &found)
+		if err != nil {
+			return err
+		}
+		environmentMap["variant"] = flattenEnvironmentVariants(found.Variants)
+
+		tfStrategies, ok := environmentMap["strategy"].([]interface{})
+		if !ok {
+			continue
+		}
+		for _, tfStrategy := range tfStrategies {
+			strategyMap := tfStrategy.(map[string]interface{})
+			tfSegments := []interface{}{}
+			for _, strategy := range found.Strategies {
+				if strategy.Id == strategyMap["id"].(string) {
+					for _, segmentId := range strategy.Segments {
+						tfSegments = append(tfSegments, segmentId)
+					}
+				}
+			}
+			strategyMap["segments"] = tfSegments
+		}
+	}
+	return nil
+}
+
+// strategySegmentsChanged tells whether the segments of a strategy differ from the ones it has in the old configuration
+// of its environment.
+func strategySegmentsChanged(oldTfEnvironment map[string]interface{}, strategyId string, segments *schema.Set) bool {
+	tfStrategies, _ := oldTfEnvironment["strategy"].([]interface{})
+	for _, tfStrategy := range tfStrategies {
+		strategyMap := tfStrategy.(map[string]interface{})
+		if strategyMap["id"] == strategyId {
+			return !strategyMap["segments"].(*schema.Set).Equal(segments)
+		}
+	}
+	return true
+}
+
+// setEnvironmentVariants replaces all the variants of a feature environment.
+func setEnvironmentVariants(ctx context.Context, client *openapiclient.APIClient, projectId string, featureName string, environment string, variants []featureEnvironmentVariant) error {
+	_, err := adminRequest(ctx, client, http.MethodPut, featureEnvironmentPath(projectId, featureName, environment)+"/variants", variants, nil)
+	return err
+}
+
+// requestEnvironmentChanges submits the changes between the old and the new configuration of an environment as a change
+// request, and records the change request in the new configuration.
+func requestEnvironmentChanges(ctx context.Context, client *openapiclient.APIClient, projectId string, featureName string, oldTfEnvironment map[string]interface{}, newTfEnvironment map[string]interface{}) error {
+	changes := environmentChanges(featureName, oldTfEnvironment, newTfEnvironment)
+	if len(changes) == 0 {
+		return nil
+	}
+
+	tfEnvironment := newTfEnvironment
+	if tfEnvironment == nil {
+		tfEnvironment = oldTfEnvironment
+	}
+	changeRequest, err := submitChangeRequest(ctx, client, projectId, tfEnvironment["name"].(string), changes, tfEnvironment["submit_change_request"].(bool))
+	if err != nil {
+		return err
+	}
+	tfEnvironment["change_request_id"] = changeRequest.Id
+	tfEnvironment["change_request_state"] = changeRequest.State
+	return nil
+}
+
+// environmentChanges lists the change request changes that turn the old configuration of an environment into the new one.
+// A nil old configuration means the environment is not managed yet, and a nil new one that it is no longer managed.
+func environmentChanges(featureName string, oldTfEnvironment map[string]interface{}, newTfEnvironment map[string]interface{}) []changeRequestChange {
+	changes := []changeRequestChange{}
+
+	oldStrategies := []changeRequestStrategy{}
+	oldVariants := []featureEnvironmentVariant{}
+	oldEnabled := false
+	if oldTfEnvironment != nil {
+		oldStrategies = toChangeRequestStrategies(oldTfEnvironment)
+		oldVariants = toEnvironmentVariants(oldTfEnvironment)
+		oldEnabled = oldTfEnvironment["enabled"].(bool)
+	}
+	newStrategies := []changeRequestStrategy{}
+	newVariants := []featureEnvironmentVariant{}
+	newEnabled := false
+	if newTfEnvironment != nil {
+		newStrategies = toChangeRequestStrategies(newTfEnvironment)
+		newVariants = toEnvironmentVariants(newTfEnvironment)
+		newEnabled = newTfEnvironment["enabled"].(bool)
+	}
+
+	for _, newStrategy := range newStrategies {
+		action := "addStrategy"
+		for _, oldStrategy := range oldStrategies {
+			if reflect.DeepEqual(newStrategy, oldStrategy) {
+				// unchanged, or still pending in a change request when it has no id
+				action = ""
+				break
+			}
+			if newStrategy.ID != "" && newStrategy.ID == oldStrategy.ID {
+				action = "updateStrategy"
+			}
+		}
+		if action != "" {
+			changes = append(changes, changeRequestChange{Feature: featureName, Action: action, Payload: newStrategy})
+		}
+	}
+	for _, oldStrategy := range oldStrategies {
+		if oldStrategy.ID == "" {
+			continue
+		}
+		found := false
+		for _, newStrategy := range newStrategies {
+			if newStrategy.ID == oldStrategy.ID {
+				found = true
+			}
+		}
+		if !found {
+			changes = append(changes, changeRequestChange{Feature: featureName, Action: "deleteStrategy", Payload: map[string]string{"id": oldStrategy.ID}})
+		}
+	}
+
+	if !reflect.DeepEqual(oldVariants, newVariants) {
+		changes = append(changes, changeRequestChange{Feature: featureName, Action: "patchVariant", Payload: map[string]interface{}{"variants": newVariants}})
+	}
+
+	if oldTfEnvironment == nil || oldEnabled != newEnabled {
+		changes = append(changes, changeRequestChange{Feature: featureName, Action: "updateEnabled", Payload: map[string]bool{"enabled": newEnabled}})
+	}
+
+	return changes
+}
+
+// changeRequestStrategy is a feature strategy along with its segments, as change requests expect it.
+type changeRequestStrategy struct {
+	api.FeatureStrategy
+	Segments []int `json:"segments"`
+}
+
+func toChangeRequestStrategies(tfEnvironment map[string]interface{}) []changeRequestStrategy {
+	strategies := []changeRequestStrategy{}
+	tfStrategies := tfEnvironment["strategy"].([]interface{})
+	for i, strategy := range toFeatureEnvironment(tfEnvironment).Strategies {
+		strategies = append(strategies, changeRequestStrategy{
+			FeatureStrategy: strategy,
+			Segments:        toSegmentIds(tfStrategies[i].(map[string]interface{})["segments"].(*schema.Set)),
+		})
+	}
+	return strategies
+}
+
+// readEnvironmentChangeRequests refreshes the change requests of the flattened environments. The environments with a
+// pending change request keep their configured values, as the changes are not applied until the change request is.
+func readEnvironmentChangeRequests(ctx context.Context, client *openapiclient.APIClient, projectId string, configured []interface{}, tfEnvironments []interface{}) error {
+	for i, tfEnvironment := range tfEnvironments {
+		environmentMap := tfEnvironment.(map[string]interface{})
+		for _, c := range configured {
+			configuredMap := c.(map[string]interface{})
+			if configuredMap["name"] != environmentMap["name"] {
+				continue
+			}
+			environmentMap["change_request"] = configuredMap["change_request"]
+			environmentMap["submit_change_request"] = configuredMap["submit_change_request"]
+
+			changeRequestId := configuredMap["change_request_id"].(int)
+			if changeRequestId == 0 {
+				break
+			}
+			changeRequest, resp, err := getChangeRequest(ctx, client, projectId, changeRequestId)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					break
+				}
+				return err
+			}
+			if contains(pendingChangeRequestStates, changeRequest.State) {
+				environmentMap = configuredMap
+				tfEnvironments[i] = environmentMap
+			}
+			environmentMap["change_request_id"] = changeRequest.Id
+			environmentMap["change_request_state"] = changeRequest.State
+			break
+		}
+	}
+	return nil
+}
+
+func featureEnvironmentPath(projectId string, featureName string, environment string) string {
+	return "/api/admin/projects/" + url.PathEscape(projectId) + "/features/" + url.PathEscape(featureName) + "/environments/" + url.PathEscape(environment)
+}
+
+func toEnvironmentVariants(tfEnvironment map[string]interface{}) []featureEnvironmentVariant {
+	variants := []featureEnvironmentVariant{}
+	tfVariants, ok := tfEnvironment["variant"].([]interface{})
+	if !ok {
+		return variants
+	}
+	for _, tfVariant := range tfVariants {
+		variantMap := tfVariant.(map[string]interface{})
+		variant := toFeatureVariant(variantMap)
+		environmentVariant := featureEnvironmentVariant{
+			Name:       variant.Name,
+			Weight:     variant.Weight,
+			WeightType: variant.WeightType,
+			Stickiness: variant.Stickiness,
+			Payload:    variant.Payload,
+			Overrides:  []variantOverride{},
+		}
+		for _, tfOverride := range variantMap["overrides"].([]interface{}) {
+			overrideMap := tfOverride.(map[string]interface{})
+			override := variantOverride{
+				ContextName: overrideMap["context_name"].(string),
+				Values:      []string{},
+			}
+			for _, value := range overrideMap["values"].([]interface{}) {
+				override.Values = append(override.Values, value.(string))
+			}
+			environmentVariant.Overrides = append(environmentVariant.Overrides, override)
+		}
+		variants = append(variants, environmentVariant)
+	}
+	return variants
+}
+
+func flattenEnvironmentVariants(variants []featureEnvironmentVariant) []interface{} {
+	tfVariants := []interface{}{}
+	for _, variant := range variants {
+		tfVariant := flattenVariants([]api.Variant{{
+			Name:       variant.Name,
+			Weight:     variant.Weight,
+			WeightType: variant.WeightType,
+			Stickiness: variant.Stickiness,
+			Payload:    variant.Payload,
+		}})[0].(map[string]interface{})
+		tfOverrides := []interface{}{}
+		for _, override := range variant.Overrides {
+			tfOverride := map[string]interface{}{}
+			tfOverride["context_name"] = override.ContextName
+			tfOverride["values"] = override.Values
+			tfOverrides = append(tfOverrides, tfOverride)
+		}
+		tfVariant["overrides"] = tfOverrides
+		tfVariants = append(tfVariants, tfVariant)
+	}
+	return tfVariants
+}
+
 func flattenTags(tags []api.FeatureTag) []interface{} {
 	if tags == nil {
 		return []interface{}{}
@@ -691,3 +1164,162 @@ func toFeatureTag(tfTag map[string]interface{}) api.FeatureTag {
 	tag.Value = tfTag["value"].(string)
 	return tag
 }
+
+func environmentVariantSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Feature environment variant. Unlike strategy variants, they apply to the whole environment. The api returns them sorted by name, so if you see drifts, sort them by name when declaring them in the configuration as well.",
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Description: "Variant name",
+					Type:        schema.TypeString,
+					Required:    true,
+				},
+				"stickiness": {
+					Description: "Variant stickiness. Default is `default`.",
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "default",
+				},
+				"weight": {
+					Description:  "Variant weight. Only considered when the `weight_type` is `fix`. It is calculated automatically if the `weight_type` is `variable`.",
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntBetween(0, 1000),
+				},
+				"weight_type": {
+					Description:  "Variant weight type. The weight type can be `fix` or `variable`. Default is `variable`.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "variable",
+					ValidateFunc: validation.StringInSlice([]string{"fix", "variable"}, false),
+				},
+				"payload": {
+					Description: "Variant payload. The type of the payload can be `string`, `json` or `csv` or `number`",
+					Type:        schema.TypeSet,
+					Optional:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"type": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"value": {
+								Description: "Always a string value, independent of the type.",
+								Type:        schema.TypeString,
+								Required:    true,
+							},
+						},
+					},
+				},
+				"overrides": {
+					Description: "Overrides that assign the variant to the users matching a context field value, regardless of the weights.",
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"context_name": {
+								Description: "The context field to match, such as `userId`.",
+								Type:        schema.TypeString,
+								Required:    true,
+							},
+							"values": {
+								Description: "The context field values that get the variant.",
+								Type:        schema.TypeList,
+								Required:    true,
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func constraintSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Strategy constraint",
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"context_name": {
+					Description:  "Constraint context. Can be `appName`, `currentTime`, `environment`, `sessionId`, `userId`, `remoteAddress` or any context field defined on the server",
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				"operator": {
+					Description:  "Constraint operator. Can be `IN`, `NOT_IN`, `STR_CONTAINS`, `STR_STARTS_WITH`, `STR_ENDS_WITH`, `NUM_EQ`, `NUM_GT`, `NUM_GTE`, `NUM_LT`, `NUM_LTE`, `SEMVER_EQ`, `SEMVER_GT` or `SEMVER_LT`",
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice([]string{"IN", "NOT_IN", "STR_CONTAINS", "STR_STARTS_WITH", "STR_ENDS_WITH", "NUM_EQ", "NUM_GT", "NUM_GTE", "NUM_LT", "NUM_LTE", "SEMVER_EQ", "SEMVER_GT", "SEMVER_LT"}, false),
+				},
+				"value": {
+					Description: "Value to use in the evaluation of the constraint. Applies only to `DATE_`, `NUM_` and `SEMVER_` operators.",
+					Type:        schema.TypeString,
+					Optional:    true,
+				},
+				"values": {
+					Description: "List of values to use in the evaluation of the constraint. Applies to all operators, except `DATE_`, `NUM_` and `SEMVER_`.",
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"case_insensitive": {
+					Description: "If operator is case-insensitive.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+				},
+				"inverted": {
+					Description: "If constraint expressions will be negated, meaning that they get their opposite value.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+				},
+			},
+		},
+	}
+}
+
+func toStrategyConstraints(tfConstraints []interface{}) []api.StrategyConstraint {
+	constraints := make([]api.StrategyConstraint, 0, len(tfConstraints))
+	for _, tfConstraint := range tfConstraints {
+		constraintMap := tfConstraint.(map[string]interface{})
+		constraint := api.StrategyConstraint{
+			ContextName:     constraintMap["context_name"].(string),
+			Operator:        constraintMap["operator"].(string),
+			Value:           constraintMap["value"].(string),
+			Values:          toStringArray(constraintMap["values"].([]interface{})),
+			Inverted:        constraintMap["inverted"].(bool),
+			CaseInsensitive: constraintMap["case_insensitive"].(bool),
+		}
+		constraints = append(constraints, constraint)
+	}
+	return constraints
+}
+
+func flattenConstraints(constraints []api.StrategyConstraint) []interface{} {
+	tfConstraints := []interface{}{}
+	for _, constraint := range constraints {
+		tfConstraint := map[string]interface{}{}
+		tfConstraint["context_name"] = constraint.ContextName
+		tfConstraint["operator"] = constraint.Operator
+		tfConstraint["value"] = constraint.Value
+		tfConstraint["values"] = constraint.Values
+		tfConstraint["inverted"] = constraint.Inverted
+		tfConstraint["case_insensitive"] = constraint.CaseInsensitive
+		tfConstraints = append(tfConstraints, tfConstraint)
+	}
+	return tfConstraints
+}
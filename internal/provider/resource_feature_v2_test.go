@@ -1,11 +1,17 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
 	"regexp"
 	"testing"
 
+	openapiclient "github.com/Unleash/unleash-server-api-go/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/philips-labs/terraform-provider-unleash/utils"
 )
 
@@ -21,32 +27,46 @@ func TestAccResourceFeatureV2(t *testing.T) {
 					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "description", "manages my nice feature"),
 					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "type", "release"),
 					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "project_id", "default"),
-					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "archive_on_destroy", "false"),
-					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.0.name", "production"),
-					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.0.enabled", "false"),
-					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.1.name", "development"),
-					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.1.enabled", "true"),
-					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.1.strategy.0.name", "remoteAddress"),
-					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.1.strategy.0.parameters.IPs", "189.434.777.123,host.test.com"),
-					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.1.strategy.0.variant.0.name", "a"),
-					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.1.strategy.0.variant.1.name", "b"),
-					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.1.strategy.0.variant.1.weight", "500"),
-					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.1.strategy.0.variant.1.weight_type", "fix"),
-					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.1.strategy.0.variant.1.payload.0.type", "string"),
-					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.1.strategy.0.variant.1.payload.0.value", "foo"),
-					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.1.strategy.1.name", "flexibleRollout"),
-					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.1.strategy.1.parameters.rollout", "68"),
-					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.1.strategy.1.parameters.stickiness", "random"),
-					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.1.strategy.1.parameters.groupId", "toggle"),
-					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.1.strategy.1.constraint.0.context_name", "appName"),
-					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.1.strategy.1.constraint.0.operator", "NUM_EQ"),
-					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.1.strategy.1.constraint.0.case_insensitive", "false"),
-					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.1.strategy.1.constraint.0.inverted", "false"),
-					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.1.strategy.1.constraint.0.value", "1"),
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "archive_on_destroy", "true"),
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.0.name", "development"),
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.0.enabled", "true"),
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.0.strategy.0.name", "remoteAddress"),
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.0.strategy.0.parameters.IPs", "189.434.777.123,host.test.com"),
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.0.strategy.0.variant.0.name", "a"),
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.0.strategy.0.variant.1.name", "b"),
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.0.strategy.0.variant.1.weight", "500"),
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.0.strategy.0.variant.1.weight_type", "fix"),
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.0.strategy.0.variant.1.payload.0.type", "string"),
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.0.strategy.0.variant.1.payload.0.value", "foo"),
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.0.strategy.1.name", "flexibleRollout"),
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.0.strategy.1.parameters.rollout", "68"),
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.0.strategy.1.parameters.stickiness", "random"),
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.0.strategy.1.parameters.groupId", "toggle"),
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.0.strategy.1.constraint.0.context_name", "appName"),
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.0.strategy.1.constraint.0.operator", "NUM_EQ"),
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.0.strategy.1.constraint.0.case_insensitive", "false"),
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.0.strategy.1.constraint.0.inverted", "false"),
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.0.strategy.1.constraint.0.value", "1"),
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.1.name", "production"),
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.1.enabled", "false"),
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.1.variant.0.name", "blue"),
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.1.variant.0.weight", "700"),
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.1.variant.0.weight_type", "variable"),
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.1.variant.0.overrides.0.context_name", "userId"),
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.1.variant.0.overrides.0.values.#", "2"),
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.1.variant.1.name", "green"),
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.1.variant.1.weight", "300"),
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.1.variant.1.payload.0.type", "json"),
 					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "tag.0.type", "simple"),
 					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "tag.0.value", "value"),
 				),
 			},
+			{
+				ResourceName:      "unleash_feature_v2.foo",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIdFunc("unleash_feature_v2.foo", "project_id", "name"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -57,12 +77,6 @@ resource "unleash_feature_v2" "foo" {
 	description        = "manages my nice feature"
 	type               = "release"
 	project_id         = "default"
-	archive_on_destroy = false
-  
-	environment {
-	  name    = "production"
-	  enabled = false
-	}
   
 	environment {
 	  name    = "development"
@@ -102,9 +116,190 @@ resource "unleash_feature_v2" "foo" {
 			}
 	  }
 	}
+  
+	environment {
+	  name    = "production"
+	  enabled = false
+
+	  variant {
+			name = "blue"
+			overrides {
+				context_name = "userId"
+				values       = ["alice", "bob"]
+			}
+	  }
+	  variant {
+			name        = "green"
+			weight      = 300
+			weight_type = "fix"
+			payload {
+				type  = "json"
+				value = "{\"color\":\"green\"}"
+			}
+	  }
+	}
 	tag {
 		type = "simple"
 		value = "value"
 	}
 }
 `, utils.RandomString(4))
+
+func TestAccResourceFeatureV2ChangeRequest(t *testing.T) {
+	randomSuffix := utils.RandomString(4)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckEnterprise(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceFeatureV2ChangeRequest(randomSuffix, "10"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.0.name", "production"),
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.0.change_request", "true"),
+					resource.TestCheckResourceAttrSet("unleash_feature_v2.foo", "environment.0.change_request_id"),
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.0.change_request_state", "In review"),
+					// the configured values are kept while the change request is pending
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.0.enabled", "true"),
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.0.strategy.0.name", "flexibleRollout"),
+				),
+			},
+			{
+				// The pending change request does not cause changes
+				Config:   testAccResourceFeatureV2ChangeRequest(randomSuffix, "10"),
+				PlanOnly: true,
+			},
+			{
+				// Changing the environment again would submit the pending strategy twice
+				Config:      testAccResourceFeatureV2ChangeRequest(randomSuffix, "20"),
+				ExpectError: regexp.MustCompile("apply or reject it in unleash before changing the environment again"),
+			},
+		},
+	})
+}
+
+func testAccResourceFeatureV2ChangeRequest(suffix string, rollout string) string {
+	return fmt.Sprintf(`
+resource "unleash_change_request_config" "foo" {
+  project_id  = "default"
+  environment = "production"
+}
+resource "unleash_feature_v2" "foo" {
+  name               = "reviewed_feature_%s"
+  type               = "release"
+  project_id         = "default"
+  archive_on_destroy = false
+
+  environment {
+    name           = "production"
+    enabled        = true
+    change_request = true
+
+    strategy {
+      name = "flexibleRollout"
+      parameters = {
+        rollout    = "%s"
+        stickiness = "default"
+        groupId    = "reviewed_feature"
+      }
+    }
+  }
+
+  depends_on = [unleash_change_request_config.foo]
+}
+`, suffix, rollout)
+}
+
+func TestReadEnvironmentDetails(t *testing.T) {
+	requests := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/admin/projects/default/features/foo/environments/development":
+			fmt.Fprint(w, `{"name":"development","variants":[{"name":"blue","weight":1000,"weightType":"variable","stickiness":"default"}],`+
+				`"strategies":[{"id":"a","segments":[1,2]},{"id":"b","segments":[]}]}`)
+		case "/api/admin/projects/default/features/foo/environments/production":
+			fmt.Fprint(w, `{"name":"production","variants":[],"strategies":[{"id":"c","segments":[3]}]}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	config := openapiclient.NewConfiguration()
+	config.Servers = openapiclient.ServerConfigurations{openapiclient.ServerConfiguration{URL: server.URL}}
+	client := openapiclient.NewAPIClient(config)
+
+	tfEnvironments := []interface{}{
+		map[string]interface{}{
+			"name": "development",
+			"strategy": []interface{}{
+				map[string]interface{}{"id": "a"},
+				map[string]interface{}{"id": "b"},
+			},
+		},
+		map[string]interface{}{
+			"name":     "production",
+			"strategy": []interface{}{map[string]interface{}{"id": "c"}},
+		},
+	}
+	err := readEnvironmentDetails(context.Background(), client, "default", "foo", tfEnvironments)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for path, count := range requests {
+		if count != 1 {
+			t.Errorf("%d requests to %s, want 1", count, path)
+		}
+	}
+	if len(requests) != 2 {
+		t.Errorf("requests = %v, want one per environment", requests)
+	}
+	segments := map[string][]interface{}{}
+	for _, tfEnvironment := range tfEnvironments {
+		for _, tfStrategy := range tfEnvironment.(map[string]interface{})["strategy"].([]interface{}) {
+			strategyMap := tfStrategy.(map[string]interface{})
+			segments[strategyMap["id"].(string)] = strategyMap["segments"].([]interface{})
+		}
+	}
+	want := map[string][]interface{}{"a": {1, 2}, "b": {}, "c": {3}}
+	if !reflect.DeepEqual(segments, want) {
+		t.Errorf("segments = %v, want %v", segments, want)
+	}
+	if variants := tfEnvironments[0].(map[string]interface{})["variant"].([]interface{}); len(variants) != 1 {
+		t.Errorf("variants of development = %v, want blue", variants)
+	}
+}
+
+func TestStrategySegmentsChanged(t *testing.T) {
+	segments := func(ids ...interface{}) *schema.Set {
+		return schema.NewSet(schema.HashInt, ids)
+	}
+	oldTfEnvironment := map[string]interface{}{
+		"strategy": []interface{}{
+			map[string]interface{}{"id": "a", "segments": segments(1, 2)},
+			map[string]interface{}{"id": "b", "segments": segments()},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		strategyId string
+		segments   *schema.Set
+		want       bool
+	}{
+		{"same segments", "a", segments(2, 1), false},
+		{"still no segments", "b", segments(), false},
+		{"segment removed", "a", segments(1), true},
+		{"segment added", "b", segments(3), true},
+		{"new strategy", "c", segments(), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := strategySegmentsChanged(oldTfEnvironment, tt.strategyId, tt.segments); got != tt.want {
+				t.Errorf("strategySegmentsChanged() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,259 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type group struct {
+	Id          int           `json:"id,omitempty"`
+	Name        string        `json:"name"`
+	Description string        `json:"description"`
+	MappingsSSO []string      `json:"mappingsSSO"`
+	RootRole    *int          `json:"rootRole"`
+	Users       []groupMember `json:"users"`
+}
+
+type groupMember struct {
+	User struct {
+		Id int `json:"id"`
+	} `json:"user"`
+}
+
+type groups struct {
+	Groups []group `json:"groups"`
+}
+
+func resourceGroup() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Provides a resource for managing unleash user groups and their members. Groups are only available in Unleash Pro and Enterprise.",
+
+		CreateContext: resourceGroupCreate,
+		ReadContext:   resourceGroupRead,
+		UpdateContext: resourceGroupUpdate,
+		DeleteContext: resourceGroupDelete,
+
+		CustomizeDiff: requireServerDiff("unleash_group", 0, 0, editionPro, editionEnterprise),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: defaultTimeouts(),
+
+		// The descriptions are used by the documentation generator and the language server.
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The group name.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "The group description.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"root_role": {
+				Description: "The id of the root role granted to all the members of the group.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"mappings_sso": {
+				Description: "The SSO group names whose members are added to this group on login.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"users": {
+				Description: "The ids of the users that are members of the group.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+		},
+	}
+}
+
+func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	newGroup := toGroup(d)
+	newGroup.Users = toGroupMembers(toGroupUserIds(d.Get("users").(*schema.Set)))
+
+	var createdGroup group
+	resp, err := adminRequest(ctx, client, http.MethodPost, "/api/admin/groups", newGroup, &createdGroup)
+	if resp == nil {
+		return diag.FromErr(fmt.Errorf("response is nil: %v", err))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(createdGroup.Id))
+	readDiags := resourceGroupRead(ctx, d, meta)
+	if readDiags != nil {
+		diags = append(diags, readDiags...)
+	}
+
+	return diags
+}
+
+func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	var foundGroup group
+	resp, err := adminRequest(ctx, client, http.MethodGet, "/api/admin/groups/"+url.PathEscape(d.Id()), nil, &foundGroup)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("name", foundGroup.Name)
+	_ = d.Set("description", foundGroup.Description)
+	if foundGroup.RootRole != nil {
+		_ = d.Set("root_role", *foundGroup.RootRole)
+	} else {
+		_ = d.Set("root_role", 0)
+	}
+	_ = d.Set("mappings_sso", foundGroup.MappingsSSO)
+	_ = d.Set("users", groupUserIds(foundGroup))
+
+	return diags
+}
+
+func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	// the update endpoint replaces the members, so start from the current members
+	// and only apply what changed in the configuration
+	var currentGroup group
+	_, err := adminRequest(ctx, client, http.MethodGet, "/api/admin/groups/"+url.PathEscape(d.Id()), nil, &currentGroup)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	userIds := groupUserIds(currentGroup)
+
+	if d.HasChange("users") {
+		o, a := d.GetChange("users")
+		old := o.(*schema.Set)
+		new := a.(*schema.Set)
+
+		userIds = applyMembershipDiff(userIds, new.Difference(old), old.Difference(new))
+	}
+
+	updatedGroup := toGroup(d)
+	updatedGroup.Users = toGroupMembers(userIds)
+
+	resp, err := adminRequest(ctx, client, http.MethodPut, "/api/admin/groups/"+url.PathEscape(d.Id()), updatedGroup, nil)
+	if resp == nil {
+		return diag.FromErr(fmt.Errorf("response is nil: %v", err))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	readDiags := resourceGroupRead(ctx, d, meta)
+	if readDiags != nil {
+		diags = append(diags, readDiags...)
+	}
+
+	return diags
+}
+
+func resourceGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	_, err := adminRequest(ctx, client, http.MethodDelete, "/api/admin/groups/"+url.PathEscape(d.Id()), nil, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return diags
+}
+
+func toGroup(d *schema.ResourceData) group {
+	g := group{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		MappingsSSO: []string{},
+	}
+	if rootRole := d.Get("root_role").(int); rootRole != 0 {
+		g.RootRole = &rootRole
+	}
+	for _, mapping := range d.Get("mappings_sso").(*schema.Set).List() {
+		g.MappingsSSO = append(g.MappingsSSO, mapping.(string))
+	}
+	return g
+}
+
+func toGroupUserIds(tfUsers *schema.Set) []int {
+	userIds := make([]int, 0, tfUsers.Len())
+	for _, v := range tfUsers.List() {
+		userIds = append(userIds, v.(int))
+	}
+	return userIds
+}
+
+func toGroupMembers(userIds []int) []groupMember {
+	members := make([]groupMember, 0, len(userIds))
+	for _, userId := range userIds {
+		member := groupMember{}
+		member.User.Id = userId
+		members = append(members, member)
+	}
+	return members
+}
+
+func groupUserIds(g group) []int {
+	userIds := make([]int, 0, len(g.Users))
+	for _, member := range g.Users {
+		userIds = append(userIds, member.User.Id)
+	}
+	return userIds
+}
+
+func flattenGroup(g group) map[string]interface{} {
+	tfGroup := map[string]interface{}{}
+	tfGroup["id"] = g.Id
+	tfGroup["name"] = g.Name
+	tfGroup["description"] = g.Description
+	if g.RootRole != nil {
+		tfGroup["root_role"] = *g.RootRole
+	} else {
+		tfGroup["root_role"] = 0
+	}
+	tfGroup["mappings_sso"] = g.MappingsSSO
+	tfGroup["users"] = groupUserIds(g)
+	return tfGroup
+}
+
+func containsInt(s []int, e int) bool {
+	for _, a := range s {
+		if a == e {
+			return true
+		}
+	}
+	return false
+}
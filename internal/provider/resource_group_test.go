@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/philips-labs/terraform-provider-unleash/utils"
+)
+
+func TestAccResourceGroup(t *testing.T) {
+	randomSuffix := utils.RandomString(4)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckEnterprise(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// Initial creation
+				Config: testAccResourceGroupInitial(randomSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_group.foo", "name", "team"+randomSuffix),
+					resource.TestCheckResourceAttr("unleash_group.foo", "description", "a team"),
+					resource.TestCheckResourceAttr("unleash_group.foo", "users.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("unleash_group.foo", "users.*", "unleash_user.foo", "id"),
+				),
+			},
+			{
+				// Update configuration
+				Config: testAccResourceGroupUpdated(randomSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updates took effect
+					resource.TestCheckResourceAttr("unleash_group.foo", "description", "the payments team"),
+					resource.TestCheckResourceAttr("unleash_group.foo", "mappings_sso.#", "1"),
+					resource.TestCheckResourceAttr("unleash_group.foo", "users.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair("unleash_group.foo", "users.*", "unleash_user.baz", "id"),
+					// Verify unchanged attributes
+					resource.TestCheckResourceAttr("unleash_group.foo", "name", "team"+randomSuffix),
+				),
+			},
+			{
+				ResourceName:      "unleash_group.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceGroupInitial(suffix string) string {
+	return fmt.Sprintf(`
+resource "unleash_user" "foo" {
+  name      = "foo"
+  email     = "foo%s@foo.com.br"
+  root_role = "Viewer"
+}
+resource "unleash_group" "foo" {
+  name        = "team%s"
+  description = "a team"
+  users       = [unleash_user.foo.id]
+}`, suffix, suffix)
+}
+
+func testAccResourceGroupUpdated(suffix string) string {
+	return fmt.Sprintf(`
+resource "unleash_user" "foo" {
+  name      = "foo"
+  email     = "foo%s@foo.com.br"
+  root_role = "Viewer"
+}
+resource "unleash_user" "baz" {
+  name      = "baz"
+  email     = "baz%s@foo.com.br"
+  root_role = "Viewer"
+}
+resource "unleash_group" "foo" {
+  name         = "team%s"
+  description  = "the payments team"
+  mappings_sso = ["payments"]
+  users        = [unleash_user.foo.id, unleash_user.baz.id]
+}`, suffix, suffix, suffix)
+}
@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	openapiclient "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceProject() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Provides a resource for managing unleash projects.",
+
+		CreateContext: resourceProjectCreate,
+		ReadContext:   resourceProjectRead,
+		UpdateContext: resourceProjectUpdate,
+		DeleteContext: resourceProjectDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: defaultTimeouts(),
+
+		// The descriptions are used by the documentation generator and the language server.
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Description: "The project id. Changing it forces a new resource to be created.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description: "The project name.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "The project description.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"mode": {
+				Description:  "The project collaboration mode. Can be `open`, `protected` or `private`. Default is `open`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "open",
+				ValidateFunc: validation.StringInSlice([]string{"open", "protected", "private"}, false),
+			},
+			"default_stickiness": {
+				Description: "The default stickiness used by variants and the gradual rollout strategy. Default is `default`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "default",
+			},
+			"feature_limit": {
+				Description:  "The maximum number of features allowed in the project. When not set, the project has no limit.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	projectId := d.Get("project_id").(string)
+	mode := d.Get("mode").(string)
+	defaultStickiness := d.Get("default_stickiness").(string)
+
+	createProjectSchema := *openapiclient.NewCreateProjectSchema(d.Get("name").(string))
+	createProjectSchema.Id = &projectId
+	createProjectSchema.Mode = &mode
+	createProjectSchema.DefaultStickiness = &defaultStickiness
+	if v, ok := d.GetOk("description"); ok {
+		description := v.(string)
+		createProjectSchema.Description = *openapiclient.NewNullableString(&description)
+	}
+	if v, ok := d.GetOk("feature_limit"); ok {
+		createProjectSchema.AdditionalProperties = map[string]interface{}{
+			"featureLimit": v.(int),
+		}
+	}
+
+	createdProject, resp, err := client.ProjectsAPI.CreateProject(ctx).CreateProjectSchema(createProjectSchema).Execute()
+	if resp == nil {
+		return diag.FromErr(fmt.Errorf("response is nil: %v", err))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdProject.Id)
+	readDiags := resourceProjectRead(ctx, d, meta)
+	if readDiags != nil {
+		diags = append(diags, readDiags...)
+	}
+
+	return diags
+}
+
+func resourceProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	projects, _, err := client.ProjectsAPI.GetProjects(ctx).Execute()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var foundProject *openapiclient.ProjectSchema
+	for i, project := range projects.Projects {
+		if project.Id == d.Id() {
+			foundProject = &projects.Projects[i]
+			break
+		}
+	}
+	if foundProject == nil {
+		d.SetId("")
+		return diags
+	}
+
+	_ = d.Set("project_id", foundProject.Id)
+	_ = d.Set("name", foundProject.Name)
+	_ = d.Set("description", foundProject.GetDescription())
+	_ = d.Set("mode", foundProject.GetMode())
+	if defaultStickiness, ok := foundProject.AdditionalProperties["defaultStickiness"].(string); ok {
+		_ = d.Set("default_stickiness", defaultStickiness)
+	}
+	if featureLimit, ok := foundProject.AdditionalProperties["featureLimit"].(float64); ok {
+		_ = d.Set("feature_limit", int(featureLimit))
+	} else {
+		_ = d.Set("feature_limit", nil)
+	}
+
+	return diags
+}
+
+func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	description := d.Get("description").(string)
+	mode := d.Get("mode").(string)
+	defaultStickiness := d.Get("default_stickiness").(string)
+
+	updateProjectSchema := *openapiclient.NewUpdateProjectSchema(d.Get("name").(string))
+	updateProjectSchema.Description = &description
+	updateProjectSchema.Mode = &mode
+	updateProjectSchema.DefaultStickiness = &defaultStickiness
+	updateProjectSchema.AdditionalProperties = map[string]interface{}{
+		"featureLimit": nil,
+	}
+	if v, ok := d.GetOk("feature_limit"); ok {
+		updateProjectSchema.AdditionalProperties["featureLimit"] = v.(int)
+	}
+
+	resp, err := client.ProjectsAPI.UpdateProject(ctx, d.Id()).UpdateProjectSchema(updateProjectSchema).Execute()
+	if resp == nil {
+		return diag.FromErr(fmt.Errorf("response is nil: %v", err))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	readDiags := resourceProjectRead(ctx, d, meta)
+	if readDiags != nil {
+		diags = append(diags, readDiags...)
+	}
+
+	return diags
+}
+
+func resourceProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	_, err := client.ProjectsAPI.DeleteProject(ctx, d.Id()).Execute()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return diags
+}
@@ -0,0 +1,292 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	openapiclient "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceProjectAccess() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Provides a resource for granting a project role to users and groups.",
+
+		CreateContext: resourceProjectAccessCreate,
+		ReadContext:   resourceProjectAccessRead,
+		UpdateContext: resourceProjectAccessUpdate,
+		DeleteContext: resourceProjectAccessDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceProjectAccessImport,
+		},
+
+		Timeouts: defaultTimeouts(),
+
+		// The descriptions are used by the documentation generator and the language server.
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Description: "The project to grant access to",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"role": {
+				Description: "The project role to grant. Can be the name of a role, such as `Owner` or `Member`, or its id. Imported accesses use the name.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"users": {
+				Description: "The ids of the users that have the role in the project.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"groups": {
+				Description: "The ids of the groups that have the role in the project.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+		},
+	}
+}
+
+func resourceProjectAccessCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	projectId := d.Get("project_id").(string)
+
+	access, resp, err := client.ProjectsAPI.GetProjectAccess(ctx, projectId).Execute()
+	if resp == nil {
+		return diag.FromErr(fmt.Errorf("response is nil: %v", err))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	role, err := findRole(access.Roles, d.Get("role").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	emptySet := &schema.Set{F: schema.HashInt}
+	err = updateProjectAccess(ctx, client, projectId, role.Id, access,
+		d.Get("users").(*schema.Set), emptySet, d.Get("groups").(*schema.Set), emptySet)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(projectId + "/" + strconv.Itoa(int(role.Id)))
+	readDiags := resourceProjectAccessRead(ctx, d, meta)
+	if readDiags != nil {
+		diags = append(diags, readDiags...)
+	}
+
+	return diags
+}
+
+func resourceProjectAccessRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	projectId, roleId, err := parseProjectAccessId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	access, resp, err := client.ProjectsAPI.GetProjectAccess(ctx, projectId).Execute()
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	role := flattenRole(access.Roles, d.Get("role").(string), roleId)
+	if role == "" {
+		// The role was deleted, and the access with it
+		d.SetId("")
+		return diags
+	}
+
+	users, groups := projectRoleMembers(access, roleId)
+
+	_ = d.Set("project_id", projectId)
+	_ = d.Set("role", role)
+	_ = d.Set("users", users[roleId])
+	_ = d.Set("groups", groups[roleId])
+
+	return diags
+}
+
+func resourceProjectAccessUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	projectId, roleId, err := parseProjectAccessId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("users", "groups") {
+		access, resp, err := client.ProjectsAPI.GetProjectAccess(ctx, projectId).Execute()
+		if resp == nil {
+			return diag.FromErr(fmt.Errorf("response is nil: %v", err))
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		oldUsers, newUsers := d.GetChange("users")
+		oldGroups, newGroups := d.GetChange("groups")
+		err = updateProjectAccess(ctx, client, projectId, roleId, access,
+			newUsers.(*schema.Set).Difference(oldUsers.(*schema.Set)),
+			oldUsers.(*schema.Set).Difference(newUsers.(*schema.Set)),
+			newGroups.(*schema.Set).Difference(oldGroups.(*schema.Set)),
+			oldGroups.(*schema.Set).Difference(newGroups.(*schema.Set)))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	readDiags := resourceProjectAccessRead(ctx, d, meta)
+	if readDiags != nil {
+		diags = append(diags, readDiags...)
+	}
+
+	return diags
+}
+
+func resourceProjectAccessDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	projectId, roleId, err := parseProjectAccessId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	access, _, err := client.ProjectsAPI.GetProjectAccess(ctx, projectId).Execute()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	emptySet := &schema.Set{F: schema.HashInt}
+	err = updateProjectAccess(ctx, client, projectId, roleId, access,
+		emptySet, d.Get("users").(*schema.Set), emptySet, d.Get("groups").(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return diags
+}
+
+func resourceProjectAccessImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Read sets the role by its name
+	if _, _, err := parseProjectAccessId(d.Id()); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// updateProjectAccess applies the membership changes of one role on top of the current access of the project.
+// The endpoint replaces the access of all the roles of the project, so the other roles are sent unchanged.
+func updateProjectAccess(ctx context.Context, client *openapiclient.APIClient, projectId string, roleId int32, access *openapiclient.ProjectAccessSchema, addUsers *schema.Set, removeUsers *schema.Set, addGroups *schema.Set, removeGroups *schema.Set) error {
+	users, groups := projectRoleMembers(access, roleId)
+	users[roleId] = applyMembershipDiff(users[roleId], addUsers, removeUsers)
+	groups[roleId] = applyMembershipDiff(groups[roleId], addGroups, removeGroups)
+
+	roles := []openapiclient.ProjectAccessConfigurationSchemaRolesInner{}
+	for _, role := range access.Roles {
+		id := role.Id
+		projectRole := *openapiclient.NewProjectAccessConfigurationSchemaRolesInner()
+		projectRole.Id = &id
+		projectRole.Users = toInt32s(users[id])
+		projectRole.Groups = toInt32s(groups[id])
+		roles = append(roles, projectRole)
+	}
+
+	resp, err := client.ProjectsAPI.SetProjectAccess(ctx, projectId).ProjectAccessConfigurationSchema(*openapiclient.NewProjectAccessConfigurationSchema(roles)).Execute()
+	if resp == nil {
+		return fmt.Errorf("response is nil: %v", err)
+	}
+	return err
+}
+
+// projectRoleMembers returns, per role id, the ids of the users and the groups that have the role in the project.
+func projectRoleMembers(access *openapiclient.ProjectAccessSchema, roleId int32) (map[int32][]int, map[int32][]int) {
+	users := map[int32][]int{roleId: {}}
+	for _, user := range access.Users {
+		for _, id := range memberRoles(user.Roles, user.RoleId) {
+			users[id] = append(users[id], int(user.Id))
+		}
+	}
+	groups := map[int32][]int{roleId: {}}
+	for _, group := range access.Groups {
+		for _, id := range memberRoles(group.Roles, group.RoleId) {
+			groups[id] = append(groups[id], int(group.Id))
+		}
+	}
+	return users, groups
+}
+
+func memberRoles(roles []int32, roleId *int32) []int32 {
+	if len(roles) > 0 {
+		return roles
+	}
+	if roleId != nil {
+		return []int32{*roleId}
+	}
+	return nil
+}
+
+func applyMembershipDiff(members []int, toAdd *schema.Set, toRemove *schema.Set) []int {
+	result := []int{}
+	for _, id := range members {
+		if !toRemove.Contains(id) {
+			result = append(result, id)
+		}
+	}
+	for _, v := range toAdd.List() {
+		if !containsInt(result, v.(int)) {
+			result = append(result, v.(int))
+		}
+	}
+	return result
+}
+
+func toInt32s(ids []int) []int32 {
+	result := make([]int32, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, int32(id))
+	}
+	return result
+}
+
+func parseProjectAccessId(id string) (string, int32, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", 0, fmt.Errorf("unexpected format of ID (%s), expected project/roleId", id)
+	}
+	roleId, err := strconv.ParseInt(parts[1], 10, 32)
+	if err != nil {
+		return "", 0, fmt.Errorf("unexpected format of ID (%s), expected project/roleId", id)
+	}
+	return parts[0], int32(roleId), nil
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/philips-labs/terraform-provider-unleash/utils"
+)
+
+func TestAccResourceProjectAccess(t *testing.T) {
+	randomSuffix := utils.RandomString(4)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckEnterprise(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// Initial creation
+				Config: testAccResourceProjectAccessInitial(randomSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_project_access.foo", "project_id", "project"+randomSuffix),
+					resource.TestCheckResourceAttr("unleash_project_access.foo", "role", "Member"),
+					resource.TestCheckResourceAttr("unleash_project_access.foo", "users.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("unleash_project_access.foo", "users.*", "unleash_user.foo", "id"),
+					resource.TestCheckResourceAttr("unleash_project_access.foo", "groups.#", "0"),
+				),
+			},
+			{
+				// Update configuration
+				Config: testAccResourceProjectAccessUpdated(randomSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updates took effect
+					resource.TestCheckResourceAttr("unleash_project_access.foo", "users.#", "0"),
+					resource.TestCheckResourceAttr("unleash_project_access.foo", "groups.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("unleash_project_access.foo", "groups.*", "unleash_group.foo", "id"),
+					// Verify unchanged attributes
+					resource.TestCheckResourceAttr("unleash_project_access.foo", "role", "Member"),
+				),
+			},
+			{
+				ResourceName:      "unleash_project_access.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceProjectAccessInitial(suffix string) string {
+	return fmt.Sprintf(`
+resource "unleash_project" "foo" {
+  project_id = "project%s"
+  name       = "project%s"
+}
+resource "unleash_user" "foo" {
+  name      = "foo"
+  username  = "foo%s"
+  email     = "foo%s@foo.com.br"
+  root_role = "Viewer"
+}
+resource "unleash_project_access" "foo" {
+  project_id = unleash_project.foo.project_id
+  role       = "Member"
+  users      = [unleash_user.foo.id]
+}`, suffix, suffix, suffix, suffix)
+}
+
+func testAccResourceProjectAccessUpdated(suffix string) string {
+	return fmt.Sprintf(`
+resource "unleash_project" "foo" {
+  project_id = "project%s"
+  name       = "project%s"
+}
+resource "unleash_user" "foo" {
+  name      = "foo"
+  username  = "foo%s"
+  email     = "foo%s@foo.com.br"
+  root_role = "Viewer"
+}
+resource "unleash_group" "foo" {
+  name = "team%s"
+}
+resource "unleash_project_access" "foo" {
+  project_id = unleash_project.foo.project_id
+  role       = "Member"
+  groups     = [unleash_group.foo.id]
+}`, suffix, suffix, suffix, suffix, suffix)
+}
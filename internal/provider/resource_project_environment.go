@@ -0,0 +1,257 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	openapiclient "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceProjectEnvironment() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Provides a resource for enabling an environment in an unleash project.",
+
+		CreateContext: resourceProjectEnvironmentCreate,
+		ReadContext:   resourceProjectEnvironmentRead,
+		UpdateContext: resourceProjectEnvironmentUpdate,
+		DeleteContext: resourceProjectEnvironmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Read:   schema.DefaultTimeout(defaultReadTimeout),
+			Update: schema.DefaultTimeout(defaultWriteTimeout),
+			Delete: schema.DefaultTimeout(defaultWriteTimeout),
+		},
+
+		// The descriptions are used by the documentation generator and the language server.
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Description: "The project the environment will be added to",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"environment": {
+				Description: "The environment to add to the project",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"default_strategy": {
+				Description: "The strategy added to features when they are enabled in this environment without any strategy. Unleash gives every project environment one, which is kept when the block is not set.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "Strategy unique name",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"title": {
+							Description: "A descriptive title for the strategy",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"parameters": {
+							Description: "Strategy parameters. All the values need to informed as strings.",
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceProjectEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	projectId := d.Get("project_id").(string)
+	environment := d.Get("environment").(string)
+
+	projectEnvironmentSchema := *openapiclient.NewProjectEnvironmentSchema(environment)
+	if s, ok := d.GetOk("default_strategy"); ok {
+		defaultStrategy := toDefaultStrategy(s.([]interface{})[0].(map[string]interface{}))
+		projectEnvironmentSchema.DefaultStrategy = &defaultStrategy
+	}
+
+	resp, err := client.ProjectsAPI.AddEnvironmentToProject(ctx, projectId).ProjectEnvironmentSchema(projectEnvironmentSchema).Execute()
+	if resp == nil {
+		return diag.FromErr(fmt.Errorf("response is nil: %v", err))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(projectId + "/" + environment)
+
+	// the environment might not be usable by the feature endpoints right away
+	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+		enabled, err := isEnvironmentEnabledInProject(ctx, client, projectId, environment)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		if !enabled {
+			return retry.RetryableError(fmt.Errorf("environment %s is not yet enabled in project %s", environment, projectId))
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	readDiags := resourceProjectEnvironmentRead(ctx, d, meta)
+	if readDiags != nil {
+		diags = append(diags, readDiags...)
+	}
+
+	return diags
+}
+
+func resourceProjectEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	projectId, environment, err := parseProjectEnvironmentId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	envs, resp, err := client.EnvironmentsAPI.GetProjectEnvironments(ctx, projectId).Execute()
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	var foundEnv *openapiclient.EnvironmentProjectSchema
+	for i, env := range envs.Environments {
+		if env.Name == environment && env.Enabled {
+			foundEnv = &envs.Environments[i]
+			break
+		}
+	}
+	if foundEnv == nil {
+		d.SetId("")
+		return diags
+	}
+
+	_ = d.Set("project_id", projectId)
+	_ = d.Set("environment", foundEnv.Name)
+
+	if foundEnv.DefaultStrategy != nil {
+		_ = d.Set("default_strategy", flattenDefaultStrategy(*foundEnv.DefaultStrategy))
+	}
+
+	return diags
+}
+
+func resourceProjectEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	projectId := d.Get("project_id").(string)
+	environment := d.Get("environment").(string)
+
+	// Removing the block keeps the default strategy of the server, so there is only something to write when it is set
+	if s, ok := d.GetOk("default_strategy"); ok && d.HasChange("default_strategy") {
+		defaultStrategy := toDefaultStrategy(s.([]interface{})[0].(map[string]interface{}))
+		path := fmt.Sprintf("/api/admin/projects/%s/environments/%s/default-strategy", url.PathEscape(projectId), url.PathEscape(environment))
+		_, err := adminRequest(ctx, client, http.MethodPost, path, defaultStrategy, nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	readDiags := resourceProjectEnvironmentRead(ctx, d, meta)
+	if readDiags != nil {
+		diags = append(diags, readDiags...)
+	}
+
+	return diags
+}
+
+func resourceProjectEnvironmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	projectId := d.Get("project_id").(string)
+	environment := d.Get("environment").(string)
+
+	_, err := client.ProjectsAPI.RemoveEnvironmentFromProject(ctx, projectId, environment).Execute()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return diags
+}
+
+// isEnvironmentEnabledInProject tells whether the project lists the environment as enabled. The feature environment
+// endpoints of the project only accept the environment from then on.
+func isEnvironmentEnabledInProject(ctx context.Context, client *openapiclient.APIClient, projectId string, environment string) (bool, error) {
+	envs, _, err := client.EnvironmentsAPI.GetProjectEnvironments(ctx, projectId).Execute()
+	if err != nil {
+		return false, err
+	}
+	for _, env := range envs.Environments {
+		if env.Name == environment {
+			return env.Enabled, nil
+		}
+	}
+	return false, nil
+}
+
+func parseProjectEnvironmentId(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected project/environment", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func toDefaultStrategy(tfStrategy map[string]interface{}) openapiclient.CreateFeatureStrategySchema {
+	strategy := *openapiclient.NewCreateFeatureStrategySchema(tfStrategy["name"].(string))
+	if title := tfStrategy["title"].(string); title != "" {
+		strategy.Title = *openapiclient.NewNullableString(&title)
+	}
+	parameters := make(map[string]string)
+	for k, v := range tfStrategy["parameters"].(map[string]interface{}) {
+		parameters[k] = v.(string)
+	}
+	strategy.Parameters = &parameters
+	return strategy
+}
+
+func flattenDefaultStrategy(strategy openapiclient.CreateFeatureStrategySchema) []interface{} {
+	tfStrategy := map[string]interface{}{}
+	tfStrategy["name"] = strategy.Name
+	tfStrategy["title"] = strategy.GetTitle()
+	tfStrategy["parameters"] = strategy.GetParameters()
+	return []interface{}{tfStrategy}
+}
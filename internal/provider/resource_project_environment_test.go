@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/philips-labs/terraform-provider-unleash/utils"
+)
+
+func TestAccResourceProjectEnvironment(t *testing.T) {
+	randomSuffix := utils.RandomString(4)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// Initial creation
+				Config: testAccResourceProjectEnvironmentInitial(randomSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("unleash_project_environment.foo", "project_id", regexp.MustCompile("^bar")),
+					resource.TestMatchResourceAttr("unleash_project_environment.foo", "environment", regexp.MustCompile("^bar")),
+				),
+			},
+			{
+				// Update configuration
+				Config: testAccResourceProjectEnvironmentUpdated(randomSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_project_environment.foo", "default_strategy.0.name", "flexibleRollout"),
+					resource.TestCheckResourceAttr("unleash_project_environment.foo", "default_strategy.0.parameters.rollout", "50"),
+				),
+			},
+			{
+				// Without the block the default strategy of the server is kept
+				Config:   testAccResourceProjectEnvironmentInitial(randomSuffix),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      "unleash_project_environment.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceProjectEnvironmentInitial(suffix string) string {
+	return fmt.Sprintf(`
+resource "unleash_project" "foo" {
+  project_id = "bar%s"
+  name       = "Bar project"
+}
+resource "unleash_environment" "foo" {
+  name = "bar%s"
+  type = "test"
+}
+resource "unleash_project_environment" "foo" {
+  project_id  = unleash_project.foo.project_id
+  environment = unleash_environment.foo.name
+}`, suffix, suffix)
+}
+
+func testAccResourceProjectEnvironmentUpdated(suffix string) string {
+	return fmt.Sprintf(`
+resource "unleash_project" "foo" {
+  project_id = "bar%s"
+  name       = "Bar project"
+}
+resource "unleash_environment" "foo" {
+  name = "bar%s"
+  type = "test"
+}
+resource "unleash_project_environment" "foo" {
+  project_id  = unleash_project.foo.project_id
+  environment = unleash_environment.foo.name
+
+  default_strategy {
+    name = "flexibleRollout"
+    parameters = {
+      rollout    = "50"
+      stickiness = "default"
+      groupId    = "foo"
+    }
+  }
+}`, suffix, suffix)
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/philips-labs/terraform-provider-unleash/utils"
+)
+
+func TestAccResourceProject(t *testing.T) {
+	randomSuffix := utils.RandomString(4)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// Initial creation
+				Config: testAccResourceProjectInitial(randomSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("unleash_project.foo", "project_id", regexp.MustCompile("^bar")),
+					resource.TestCheckResourceAttr("unleash_project.foo", "name", "Bar project"),
+					resource.TestCheckResourceAttr("unleash_project.foo", "description", "managed by terraform"),
+					resource.TestCheckResourceAttr("unleash_project.foo", "mode", "open"),
+					resource.TestCheckResourceAttr("unleash_project.foo", "default_stickiness", "default"),
+				),
+			},
+			{
+				// Update configuration
+				Config: testAccResourceProjectUpdated(randomSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updates took effect
+					resource.TestCheckResourceAttr("unleash_project.foo", "name", "Bar project renamed"),
+					resource.TestCheckResourceAttr("unleash_project.foo", "description", ""),
+					resource.TestCheckResourceAttr("unleash_project.foo", "default_stickiness", "userId"),
+					// Verify unchanged attributes
+					resource.TestMatchResourceAttr("unleash_project.foo", "project_id", regexp.MustCompile("^bar")),
+				),
+			},
+			{
+				ResourceName:      "unleash_project.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceProjectInitial(suffix string) string {
+	return fmt.Sprintf(`
+resource "unleash_project" "foo" {
+  project_id  = "bar%s"
+  name        = "Bar project"
+  description = "managed by terraform"
+}`, suffix)
+}
+
+func testAccResourceProjectUpdated(suffix string) string {
+	return fmt.Sprintf(`
+resource "unleash_project" "foo" {
+  project_id         = "bar%s"
+  name               = "Bar project renamed"
+  default_stickiness = "userId"
+}`, suffix)
+}
@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type publicSignupToken struct {
+	Secret    string                  `json:"secret"`
+	Url       string                  `json:"url"`
+	Name      string                  `json:"name"`
+	Enabled   bool                    `json:"enabled"`
+	ExpiresAt string                  `json:"expiresAt"`
+	CreatedAt string                  `json:"createdAt"`
+	CreatedBy string                  `json:"createdBy"`
+	Role      publicSignupTokenRole   `json:"role"`
+	Users     []publicSignupTokenUser `json:"users"`
+}
+
+type publicSignupTokenRole struct {
+	Id   int32  `json:"id"`
+	Name string `json:"name"`
+}
+
+type publicSignupTokenUser struct {
+	Id       int    `json:"id"`
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	Username string `json:"username"`
+}
+
+type publicSignupTokens struct {
+	Tokens []publicSignupToken `json:"tokens"`
+}
+
+type publicSignupTokenCreate struct {
+	Name      string `json:"name"`
+	ExpiresAt string `json:"expiresAt"`
+	RoleId    int32  `json:"roleId,omitempty"`
+}
+
+type publicSignupTokenUpdate struct {
+	ExpiresAt string `json:"expiresAt,omitempty"`
+	Enabled   bool   `json:"enabled"`
+}
+
+func resourcePublicSignupToken() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Provides a resource for managing unleash public signup tokens, which give invite links that anyone can use to sign up.",
+
+		CreateContext: resourcePublicSignupTokenCreate,
+		ReadContext:   resourcePublicSignupTokenRead,
+		UpdateContext: resourcePublicSignupTokenUpdate,
+		DeleteContext: resourcePublicSignupTokenDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: defaultTimeouts(),
+
+		// The descriptions are used by the documentation generator and the language server.
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The token name. Changing it forces a new resource to be created.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"expires_at": {
+				Description:  "The token expiration date in RFC3339 format.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"root_role": {
+				Description: "The root role of the users signing up with the token. Can be the name of a role, such as `Editor` or `Viewer`, or its id. " +
+					"Unleash uses `Viewer` when it is not set, and versions of unleash that can not choose the role always use `Viewer`. Changing it forces a new resource to be created.",
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"enabled": {
+				Description: "Whether the token can be used to sign up. Default is `true`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"url": {
+				Description: "The signup URL to share with the users.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"secret": {
+				Description: "The token secret.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func resourcePublicSignupTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	newToken := publicSignupTokenCreate{
+		Name:      d.Get("name").(string),
+		ExpiresAt: d.Get("expires_at").(string),
+	}
+	if rootRole, ok := d.GetOk("root_role"); ok {
+		roles, err := getRoles(ctx, client)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		role, err := findRole(roles, rootRole.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		newToken.RoleId = role.Id
+	}
+
+	var createdToken publicSignupToken
+	resp, err := adminRequest(ctx, client, http.MethodPost, "/api/admin/invite-link/tokens", newToken, &createdToken)
+	if resp == nil {
+		return diag.FromErr(fmt.Errorf("response is nil: %v", err))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdToken.Secret)
+
+	// Tokens are always created enabled
+	if !d.Get("enabled").(bool) {
+		diags = append(diags, resourcePublicSignupTokenUpdate(ctx, d, meta)...)
+		return diags
+	}
+
+	readDiags := resourcePublicSignupTokenRead(ctx, d, meta)
+	if readDiags != nil {
+		diags = append(diags, readDiags...)
+	}
+
+	return diags
+}
+
+func resourcePublicSignupTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	var token publicSignupToken
+	resp, err := adminRequest(ctx, client, http.MethodGet, "/api/admin/invite-link/tokens/"+url.PathEscape(d.Id()), nil, &token)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("name", token.Name)
+	_ = d.Set("enabled", token.Enabled)
+	_ = d.Set("url", token.Url)
+	_ = d.Set("secret", token.Secret)
+	rootRole, err := flattenRootRole(ctx, client, d.Get("root_role").(string), token.Role.Id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_ = d.Set("root_role", rootRole)
+	// Keeps the configured expiration date when it is the same time in another format, as the api returns it in UTC
+	expiresAt, err := time.Parse(time.RFC3339, token.ExpiresAt)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	configuredExpiresAt, err := time.Parse(time.RFC3339, d.Get("expires_at").(string))
+	if err != nil || !configuredExpiresAt.Equal(expiresAt) {
+		_ = d.Set("expires_at", expiresAt.Format(time.RFC3339))
+	}
+
+	return diags
+}
+
+func resourcePublicSignupTokenUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	updatedToken := publicSignupTokenUpdate{
+		ExpiresAt: d.Get("expires_at").(string),
+		Enabled:   d.Get("enabled").(bool),
+	}
+
+	resp, err := adminRequest(ctx, client, http.MethodPut, "/api/admin/invite-link/tokens/"+url.PathEscape(d.Id()), updatedToken, nil)
+	if resp == nil {
+		return diag.FromErr(fmt.Errorf("response is nil: %v", err))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	readDiags := resourcePublicSignupTokenRead(ctx, d, meta)
+	if readDiags != nil {
+		diags = append(diags, readDiags...)
+	}
+
+	return diags
+}
+
+// Disables the token, as unleash can not delete public signup tokens
+func resourcePublicSignupTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	_, err := adminRequest(ctx, client, http.MethodPut, "/api/admin/invite-link/tokens/"+url.PathEscape(d.Id()), publicSignupTokenUpdate{Enabled: false}, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return diags
+}
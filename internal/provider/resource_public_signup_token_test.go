@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/philips-labs/terraform-provider-unleash/utils"
+)
+
+func TestAccResourcePublicSignupToken(t *testing.T) {
+	randomSuffix := utils.RandomString(4)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// Initial creation
+				Config: testAccResourcePublicSignupTokenInitial(randomSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_public_signup_token.foo", "name", "onboarding"+randomSuffix),
+					resource.TestCheckResourceAttr("unleash_public_signup_token.foo", "expires_at", "2099-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("unleash_public_signup_token.foo", "root_role", "Viewer"),
+					resource.TestCheckResourceAttr("unleash_public_signup_token.foo", "enabled", "true"),
+					resource.TestCheckResourceAttrSet("unleash_public_signup_token.foo", "url"),
+				),
+			},
+			{
+				// Update configuration
+				Config: testAccResourcePublicSignupTokenUpdated(randomSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updates took effect
+					resource.TestCheckResourceAttr("unleash_public_signup_token.foo", "expires_at", "2098-06-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("unleash_public_signup_token.foo", "enabled", "false"),
+					// Verify unchanged attributes
+					resource.TestCheckResourceAttr("unleash_public_signup_token.foo", "name", "onboarding"+randomSuffix),
+					resource.TestCheckResourceAttrSet("data.unleash_public_signup_tokens.all", "tokens.#"),
+				),
+			},
+			{
+				ResourceName:      "unleash_public_signup_token.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourcePublicSignupTokenInitial(suffix string) string {
+	return fmt.Sprintf(`
+resource "unleash_public_signup_token" "foo" {
+  name       = "onboarding%s"
+  expires_at = "2099-01-01T00:00:00Z"
+  root_role  = "Viewer"
+}`, suffix)
+}
+
+func testAccResourcePublicSignupTokenUpdated(suffix string) string {
+	return fmt.Sprintf(`
+resource "unleash_public_signup_token" "foo" {
+  name       = "onboarding%s"
+  expires_at = "2098-06-01T00:00:00Z"
+  root_role  = "Viewer"
+  enabled    = false
+}
+data "unleash_public_signup_tokens" "all" {
+  depends_on = [unleash_public_signup_token.foo]
+}`, suffix)
+}
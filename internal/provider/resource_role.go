@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	openapiclient "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// roleTypes maps the role types of the provider to the custom role types of unleash.
+var roleTypes = map[string]string{
+	"root":    "root-custom",
+	"project": "custom",
+}
+
+func resourceRole() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Provides a resource for managing unleash custom roles. Custom roles are only available in Unleash Enterprise.",
+
+		CreateContext: resourceRoleCreate,
+		ReadContext:   resourceRoleRead,
+		UpdateContext: resourceRoleUpdate,
+		DeleteContext: resourceRoleDelete,
+
+		CustomizeDiff: requireServerDiff("unleash_role", 0, 0, editionEnterprise),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: defaultTimeouts(),
+
+		// The descriptions are used by the documentation generator and the language server.
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The role name.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "The role description.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"type": {
+				Description:  "The role type. Can be `root` for a custom root role or `project` for a custom project role. Changing it forces a new resource to be created.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"root", "project"}, false),
+			},
+			"permission": {
+				Description: "Permission granted by the role",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "The permission name, such as `CREATE_FEATURE` or `UPDATE_FEATURE_ENVIRONMENT`.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"environment": {
+							Description: "The environment the permission applies to. Only for environment specific permissions.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceRoleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	createdRole, resp, err := client.UsersAPI.CreateRole(ctx).CreateRoleWithPermissionsSchema(toRole(d)).Execute()
+	if resp == nil {
+		return diag.FromErr(fmt.Errorf("response is nil: %v", err))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(int(createdRole.Roles.Id)))
+	readDiags := resourceRoleRead(ctx, d, meta)
+	if readDiags != nil {
+		diags = append(diags, readDiags...)
+	}
+
+	return diags
+}
+
+func resourceRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	role, resp, err := client.UsersAPI.GetRoleById(ctx, d.Id()).Execute()
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("name", role.Name)
+	_ = d.Set("description", role.GetDescription())
+	for k, v := range roleTypes {
+		if v == role.Type {
+			_ = d.Set("type", k)
+		}
+	}
+
+	permissions := []interface{}{}
+	for _, permission := range role.Permissions {
+		tfPermission := map[string]interface{}{}
+		tfPermission["name"] = permission.Name
+		tfPermission["environment"] = permission.GetEnvironment()
+		permissions = append(permissions, tfPermission)
+	}
+	_ = d.Set("permission", permissions)
+
+	return diags
+}
+
+func resourceRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	_, resp, err := client.UsersAPI.UpdateRole(ctx, d.Id()).CreateRoleWithPermissionsSchema(toRole(d)).Execute()
+	if resp == nil {
+		return diag.FromErr(fmt.Errorf("response is nil: %v", err))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	readDiags := resourceRoleRead(ctx, d, meta)
+	if readDiags != nil {
+		diags = append(diags, readDiags...)
+	}
+
+	return diags
+}
+
+func resourceRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	_, err := client.UsersAPI.DeleteRole(ctx, d.Id()).Execute()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return diags
+}
+
+func toRole(d *schema.ResourceData) openapiclient.CreateRoleWithPermissionsSchema {
+	description := d.Get("description").(string)
+	roleType := roleTypes[d.Get("type").(string)]
+
+	role := openapiclient.NewCreateRoleWithPermissionsSchemaAnyOf(d.Get("name").(string))
+	role.Description = &description
+	role.Type = &roleType
+	role.Permissions = []openapiclient.CreateRoleWithPermissionsSchemaAnyOfPermissionsInner{}
+	for _, tfPermission := range d.Get("permission").(*schema.Set).List() {
+		permissionMap := tfPermission.(map[string]interface{})
+		permission := *openapiclient.NewCreateRoleWithPermissionsSchemaAnyOfPermissionsInner(permissionMap["name"].(string))
+		if environment := permissionMap["environment"].(string); environment != "" {
+			permission.Environment = *openapiclient.NewNullableString(&environment)
+		}
+		role.Permissions = append(role.Permissions, permission)
+	}
+
+	return openapiclient.CreateRoleWithPermissionsSchema{CreateRoleWithPermissionsSchemaAnyOf: role}
+}
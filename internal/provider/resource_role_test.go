@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/philips-labs/terraform-provider-unleash/utils"
+)
+
+func TestAccResourceRole(t *testing.T) {
+	randomSuffix := utils.RandomString(4)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckEnterprise(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// Initial creation
+				Config: testAccResourceRoleInitial(randomSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_role.foo", "name", "auditor"+randomSuffix),
+					resource.TestCheckResourceAttr("unleash_role.foo", "type", "root"),
+					resource.TestCheckResourceAttr("unleash_role.foo", "permission.#", "1"),
+					resource.TestCheckResourceAttrPair("unleash_user.foo", "root_role", "unleash_role.foo", "id"),
+				),
+			},
+			{
+				// Update configuration
+				Config: testAccResourceRoleUpdated(randomSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updates took effect
+					resource.TestCheckResourceAttr("unleash_role.foo", "description", "reads everything"),
+					resource.TestCheckResourceAttr("unleash_role.foo", "permission.#", "2"),
+					resource.TestCheckResourceAttr("unleash_user.foo", "root_role", "auditor"+randomSuffix),
+					// Verify unchanged attributes
+					resource.TestCheckResourceAttr("unleash_role.foo", "name", "auditor"+randomSuffix),
+					resource.TestCheckResourceAttr("unleash_role.foo", "type", "root"),
+				),
+			},
+			{
+				ResourceName:      "unleash_role.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceRoleInitial(suffix string) string {
+	return fmt.Sprintf(`
+resource "unleash_role" "foo" {
+  name = "auditor%s"
+  type = "root"
+
+  permission {
+    name = "READ_LOGS"
+  }
+}
+resource "unleash_user" "foo" {
+  name      = "foo"
+  username  = "foo%s"
+  email     = "foo%s@foo.com.br"
+  root_role = unleash_role.foo.id
+}`, suffix, suffix, suffix)
+}
+
+func testAccResourceRoleUpdated(suffix string) string {
+	return fmt.Sprintf(`
+resource "unleash_role" "foo" {
+  name        = "auditor%s"
+  description = "reads everything"
+  type        = "root"
+
+  permission {
+    name = "READ_LOGS"
+  }
+  permission {
+    name = "READ_ADDON"
+  }
+}
+resource "unleash_user" "foo" {
+  name      = "foo"
+  username  = "foo%s"
+  email     = "foo%s@foo.com.br"
+  root_role = unleash_role.foo.name
+}`, suffix, suffix, suffix)
+}
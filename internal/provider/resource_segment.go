@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	openapiclient "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/philips-labs/go-unleash-api/v2/api"
+)
+
+type segment struct {
+	Id          int                      `json:"id,omitempty"`
+	Name        string                   `json:"name"`
+	Description string                   `json:"description"`
+	Project     *string                  `json:"project"`
+	Constraints []api.StrategyConstraint `json:"constraints"`
+}
+
+type segments struct {
+	Segments []segment `json:"segments"`
+}
+
+type strategySegments struct {
+	ProjectId     string `json:"projectId"`
+	StrategyId    string `json:"strategyId"`
+	EnvironmentId string `json:"environmentId"`
+	SegmentIds    []int  `json:"segmentIds"`
+}
+
+func resourceSegment() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Provides a resource for managing unleash segments, reusable sets of constraints that strategies can reference.",
+
+		CreateContext: resourceSegmentCreate,
+		ReadContext:   resourceSegmentRead,
+		UpdateContext: resourceSegmentUpdate,
+		DeleteContext: resourceSegmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: defaultTimeouts(),
+
+		// The descriptions are used by the documentation generator and the language server.
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Segment name",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "Segment description",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"project_id": {
+				Description: "The project the segment is scoped to. When not set, the segment is available in all projects.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"constraint": constraintSchema(),
+		},
+	}
+}
+
+func resourceSegmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	body := toSegment(d)
+	err := validateConstraintContexts(ctx, client, body.Constraints)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var createdSegment segment
+	resp, err := adminRequest(ctx, client, http.MethodPost, "/api/admin/segments", body, &createdSegment)
+	if resp == nil {
+		return diag.FromErr(fmt.Errorf("response is nil: %v", err))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(createdSegment.Id))
+	readDiags := resourceSegmentRead(ctx, d, meta)
+	if readDiags != nil {
+		diags = append(diags, readDiags...)
+	}
+
+	return diags
+}
+
+func resourceSegmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	var foundSegment segment
+	resp, err := adminRequest(ctx, client, http.MethodGet, "/api/admin/segments/"+url.PathEscape(d.Id()), nil, &foundSegment)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("name", foundSegment.Name)
+	_ = d.Set("description", foundSegment.Description)
+	if foundSegment.Project != nil {
+		_ = d.Set("project_id", *foundSegment.Project)
+	} else {
+		_ = d.Set("project_id", "")
+	}
+	_ = d.Set("constraint", flattenConstraints(foundSegment.Constraints))
+
+	return diags
+}
+
+func resourceSegmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	body := toSegment(d)
+	err := validateConstraintContexts(ctx, client, body.Constraints)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := adminRequest(ctx, client, http.MethodPut, "/api/admin/segments/"+url.PathEscape(d.Id()), body, nil)
+	if resp == nil {
+		return diag.FromErr(fmt.Errorf("response is nil: %v", err))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	readDiags := resourceSegmentRead(ctx, d, meta)
+	if readDiags != nil {
+		diags = append(diags, readDiags...)
+	}
+
+	return diags
+}
+
+func resourceSegmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	_, err := adminRequest(ctx, client, http.MethodDelete, "/api/admin/segments/"+url.PathEscape(d.Id()), nil, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return diags
+}
+
+func toSegment(d *schema.ResourceData) segment {
+	s := segment{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Constraints: toStrategyConstraints(d.Get("constraint").([]interface{})),
+	}
+	if projectId := d.Get("project_id").(string); projectId != "" {
+		s.Project = &projectId
+	}
+	return s
+}
+
+// setStrategySegments replaces the segments used by a feature strategy.
+func setStrategySegments(ctx context.Context, client *openapiclient.APIClient, projectId string, environment string, strategyId string, segmentIds []int) error {
+	body := strategySegments{
+		ProjectId:     projectId,
+		StrategyId:    strategyId,
+		EnvironmentId: environment,
+		SegmentIds:    segmentIds,
+	}
+	_, err := adminRequest(ctx, client, http.MethodPost, "/api/admin/segments/strategies", body, nil)
+	return err
+}
+
+func getStrategySegments(ctx context.Context, client *openapiclient.APIClient, strategyId string) ([]int, error) {
+	var found segments
+	_, err := adminRequest(ctx, client, http.MethodGet, "/api/admin/segments/strategies/"+url.PathEscape(strategyId), nil, &found)
+	if err != nil {
+		return nil, err
+	}
+	segmentIds := make([]int, 0, len(found.Segments))
+	for _, s := range found.Segments {
+		segmentIds = append(segmentIds, s.Id)
+	}
+	return segmentIds, nil
+}
+
+func toSegmentIds(tfSegments *schema.Set) []int {
+	segmentIds := make([]int, 0, tfSegments.Len())
+	for _, v := range tfSegments.List() {
+		segmentIds = append(segmentIds, v.(int))
+	}
+	return segmentIds
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/philips-labs/terraform-provider-unleash/utils"
+)
+
+func TestAccResourceSegment(t *testing.T) {
+	randomSuffix := utils.RandomString(4)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// Initial creation
+				Config: testAccResourceSegmentInitial(randomSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("unleash_segment.foo", "name", regexp.MustCompile("^bar")),
+					resource.TestCheckResourceAttr("unleash_segment.foo", "description", "beta customers"),
+					resource.TestCheckResourceAttr("unleash_segment.foo", "constraint.0.context_name", "userId"),
+					resource.TestCheckResourceAttr("unleash_segment.foo", "constraint.0.operator", "IN"),
+					resource.TestCheckResourceAttr("unleash_segment.foo", "constraint.0.values.#", "2"),
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.0.strategy.0.segments.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("unleash_feature_v2.foo", "environment.0.strategy.0.segments.*", "unleash_segment.foo", "id"),
+				),
+			},
+			{
+				// Update configuration
+				Config: testAccResourceSegmentUpdated(randomSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updates took effect
+					resource.TestCheckResourceAttr("unleash_segment.foo", "description", "beta customers in the default project"),
+					resource.TestCheckResourceAttr("unleash_segment.foo", "project_id", "default"),
+					resource.TestCheckResourceAttr("unleash_segment.foo", "constraint.0.values.#", "3"),
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "environment.0.strategy.0.segments.#", "0"),
+					// Verify unchanged attributes
+					resource.TestMatchResourceAttr("unleash_segment.foo", "name", regexp.MustCompile("^bar")),
+				),
+			},
+			{
+				ResourceName:      "unleash_segment.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceSegmentInitial(suffix string) string {
+	return fmt.Sprintf(`
+resource "unleash_segment" "foo" {
+  name        = "bar%s"
+  description = "beta customers"
+
+  constraint {
+    context_name = "userId"
+    operator     = "IN"
+    values       = ["alice", "bob"]
+  }
+}
+resource "unleash_feature_v2" "foo" {
+  name               = "bar%s"
+  type               = "release"
+  project_id         = "default"
+  archive_on_destroy = false
+
+  environment {
+    name = "development"
+
+    strategy {
+      name     = "default"
+      segments = [unleash_segment.foo.id]
+    }
+  }
+}`, suffix, suffix)
+}
+
+func testAccResourceSegmentUpdated(suffix string) string {
+	return fmt.Sprintf(`
+resource "unleash_segment" "foo" {
+  name        = "bar%s"
+  description = "beta customers in the default project"
+  project_id  = "default"
+
+  constraint {
+    context_name = "userId"
+    operator     = "IN"
+    values       = ["alice", "bob", "carol"]
+  }
+}
+resource "unleash_feature_v2" "foo" {
+  name               = "bar%s"
+  type               = "release"
+  project_id         = "default"
+  archive_on_destroy = false
+
+  environment {
+    name = "development"
+
+    strategy {
+      name = "default"
+    }
+  }
+}`, suffix, suffix)
+}
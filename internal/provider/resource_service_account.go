@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	openapiclient "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceServiceAccount() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Provides a resource for managing unleash service accounts.",
+
+		CreateContext: resourceServiceAccountCreate,
+		ReadContext:   resourceServiceAccountRead,
+		UpdateContext: resourceServiceAccountUpdate,
+		DeleteContext: resourceServiceAccountDelete,
+
+		CustomizeDiff: requireServerDiff("unleash_service_account", 0, 0, editionPro, editionEnterprise),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: defaultTimeouts(),
+
+		// The descriptions are used by the documentation generator and the language server.
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The service account name.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"username": {
+				Description: "The service account username. Changing it forces a new resource to be created.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"root_role": {
+				Description:  "The root role of the service account. Can be the name of a role, such as `Admin`, `Editor` or `Viewer`, or its id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func resourceServiceAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	roles, err := getRoles(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	rootRole, err := findRole(roles, d.Get("root_role").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	createServiceAccountSchema := *openapiclient.NewCreateServiceAccountSchema(d.Get("username").(string), d.Get("name").(string), rootRole.Id)
+
+	createdServiceAccount, resp, err := client.ServiceAccountsAPI.CreateServiceAccount(ctx).CreateServiceAccountSchema(createServiceAccountSchema).Execute()
+	if resp == nil {
+		return diag.FromErr(fmt.Errorf("response is nil: %v", err))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(int(createdServiceAccount.Id)))
+	readDiags := resourceServiceAccountRead(ctx, d, meta)
+	if readDiags != nil {
+		diags = append(diags, readDiags...)
+	}
+
+	return diags
+}
+
+func resourceServiceAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	serviceAccount, err := findServiceAccount(ctx, client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if serviceAccount == nil {
+		d.SetId("")
+		return diags
+	}
+
+	_ = d.Set("name", serviceAccount.GetName())
+	_ = d.Set("username", serviceAccount.GetUsername())
+	rootRole, err := flattenRootRole(ctx, client, d.Get("root_role").(string), serviceAccount.GetRootRole())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_ = d.Set("root_role", rootRole)
+
+	return diags
+}
+
+func resourceServiceAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	roles, err := getRoles(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	rootRole, err := findRole(roles, d.Get("root_role").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	name := d.Get("name").(string)
+
+	updateServiceAccountSchema := *openapiclient.NewUpdateServiceAccountSchema()
+	updateServiceAccountSchema.Name = &name
+	updateServiceAccountSchema.RootRole = &rootRole.Id
+
+	_, resp, err := client.ServiceAccountsAPI.UpdateServiceAccount(ctx, d.Id()).UpdateServiceAccountSchema(updateServiceAccountSchema).Execute()
+	if resp == nil {
+		return diag.FromErr(fmt.Errorf("response is nil: %v", err))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	readDiags := resourceServiceAccountRead(ctx, d, meta)
+	if readDiags != nil {
+		diags = append(diags, readDiags...)
+	}
+
+	return diags
+}
+
+func resourceServiceAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	_, err := client.ServiceAccountsAPI.DeleteServiceAccount(ctx, d.Id()).Execute()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return diags
+}
+
+// findServiceAccount returns the service account with the given id, or nil when there is no such service account.
+func findServiceAccount(ctx context.Context, client *openapiclient.APIClient, id string) (*openapiclient.ServiceAccountSchema, error) {
+	serviceAccounts, _, err := client.ServiceAccountsAPI.GetServiceAccounts(ctx).Execute()
+	if err != nil {
+		return nil, err
+	}
+	for i, serviceAccount := range serviceAccounts.ServiceAccounts {
+		if strconv.Itoa(int(serviceAccount.Id)) == id {
+			return &serviceAccounts.ServiceAccounts[i], nil
+		}
+	}
+	return nil, nil
+}
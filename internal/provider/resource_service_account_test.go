@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/philips-labs/terraform-provider-unleash/utils"
+)
+
+func TestAccResourceServiceAccount(t *testing.T) {
+	randomSuffix := utils.RandomString(4)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckEnterprise(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// Initial creation
+				Config: testAccResourceServiceAccountInitial(randomSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_service_account.foo", "name", "ci"),
+					resource.TestCheckResourceAttr("unleash_service_account.foo", "username", "ci"+randomSuffix),
+					resource.TestCheckResourceAttr("unleash_service_account.foo", "root_role", "Editor"),
+					resource.TestCheckResourceAttrPair("unleash_service_account_token.foo", "service_account_id", "unleash_service_account.foo", "id"),
+					resource.TestCheckResourceAttr("unleash_service_account_token.foo", "description", "deployments"),
+					resource.TestMatchResourceAttr("unleash_service_account_token.foo", "secret", regexp.MustCompile(`^user:`)),
+				),
+			},
+			{
+				// Update configuration
+				Config: testAccResourceServiceAccountUpdated(randomSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updates took effect
+					resource.TestCheckResourceAttr("unleash_service_account.foo", "name", "ci pipeline"),
+					resource.TestCheckResourceAttr("unleash_service_account.foo", "root_role", "Viewer"),
+					// Verify unchanged attributes
+					resource.TestCheckResourceAttr("unleash_service_account.foo", "username", "ci"+randomSuffix),
+					resource.TestCheckResourceAttr("unleash_service_account_token.foo", "description", "deployments"),
+				),
+			},
+			{
+				ResourceName:      "unleash_service_account.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:            "unleash_service_account_token.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret"},
+			},
+		},
+	})
+}
+
+func testAccResourceServiceAccountInitial(suffix string) string {
+	return fmt.Sprintf(`
+resource "unleash_service_account" "foo" {
+  name      = "ci"
+  username  = "ci%s"
+  root_role = "Editor"
+}
+resource "unleash_service_account_token" "foo" {
+  service_account_id = unleash_service_account.foo.id
+  description        = "deployments"
+  expires_at         = "2099-01-01T00:00:00Z"
+}`, suffix)
+}
+
+func testAccResourceServiceAccountUpdated(suffix string) string {
+	return fmt.Sprintf(`
+resource "unleash_service_account" "foo" {
+  name      = "ci pipeline"
+  username  = "ci%s"
+  root_role = "Viewer"
+}
+resource "unleash_service_account_token" "foo" {
+  service_account_id = unleash_service_account.foo.id
+  description        = "deployments"
+  expires_at         = "2099-01-01T00:00:00Z"
+}`, suffix)
+}
@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	openapiclient "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceServiceAccountToken() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Provides a resource for managing the tokens of an unleash service account.",
+
+		CreateContext: resourceServiceAccountTokenCreate,
+		ReadContext:   resourceServiceAccountTokenRead,
+		DeleteContext: resourceServiceAccountTokenDelete,
+
+		CustomizeDiff: requireServerDiff("unleash_service_account_token", 0, 0, editionPro, editionEnterprise),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceServiceAccountTokenImport,
+		},
+
+		// Tokens can not be updated
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultWriteTimeout),
+			Read:   schema.DefaultTimeout(defaultReadTimeout),
+			Delete: schema.DefaultTimeout(defaultWriteTimeout),
+		},
+
+		// The descriptions are used by the documentation generator and the language server.
+		Schema: map[string]*schema.Schema{
+			"service_account_id": {
+				Description: "The id of the service account the token belongs to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"description": {
+				Description: "The token description.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"expires_at": {
+				Description:  "The token expiration date in RFC3339 format.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"created_at": {
+				Description: "The token creation date.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"secret": {
+				Description: "The token secret. It is only known when the token is created, so it is empty for imported tokens.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func resourceServiceAccountTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	serviceAccountId := d.Get("service_account_id").(string)
+	expiresAt, err := time.Parse(time.RFC3339, d.Get("expires_at").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	createPatSchema := *openapiclient.NewCreatePatSchema(d.Get("description").(string), expiresAt)
+
+	createdToken, resp, err := client.ServiceAccountsAPI.CreateServiceAccountToken(ctx, serviceAccountId).CreatePatSchema(createPatSchema).Execute()
+	if resp == nil {
+		return diag.FromErr(fmt.Errorf("response is nil: %v", err))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("secret", createdToken.GetSecret())
+	d.SetId(serviceAccountId + "/" + strconv.Itoa(int(createdToken.Id)))
+	readDiags := resourceServiceAccountTokenRead(ctx, d, meta)
+	if readDiags != nil {
+		diags = append(diags, readDiags...)
+	}
+
+	return diags
+}
+
+func resourceServiceAccountTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	parts, err := parseImportId(d.Id(), "serviceAccountId/tokenId")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	serviceAccountId, tokenId := parts[0], parts[1]
+
+	tokens, resp, err := client.ServiceAccountsAPI.GetServiceAccountTokens(ctx, serviceAccountId).Execute()
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	var foundToken *openapiclient.PatSchema
+	for i, token := range tokens.Pats {
+		if strconv.Itoa(int(token.Id)) == tokenId {
+			foundToken = &tokens.Pats[i]
+			break
+		}
+	}
+	if foundToken == nil {
+		d.SetId("")
+		return diags
+	}
+
+	_ = d.Set("service_account_id", serviceAccountId)
+	_ = d.Set("description", foundToken.Description)
+	_ = d.Set("created_at", foundToken.CreatedAt.Format(time.RFC3339))
+
+	return diags
+}
+
+func resourceServiceAccountTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	parts, err := parseImportId(d.Id(), "serviceAccountId/tokenId")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.ServiceAccountsAPI.DeleteServiceAccountToken(ctx, parts[0], parts[1]).Execute()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return diags
+}
+
+func resourceServiceAccountTokenImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*ApiClients).UnleashClient
+
+	parts, err := parseImportId(d.Id(), "serviceAccountId/tokenId")
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, _, err := client.ServiceAccountsAPI.GetServiceAccountTokens(ctx, parts[0]).Execute()
+	if err != nil {
+		return nil, err
+	}
+	// Read does not refresh the expiration date, as it can not change after the token is created
+	for _, token := range tokens.Pats {
+		if strconv.Itoa(int(token.Id)) == parts[1] {
+			_ = d.Set("expires_at", token.ExpiresAt.Format(time.RFC3339))
+		}
+	}
+	return []*schema.ResourceData{d}, nil
+}
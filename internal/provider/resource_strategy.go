@@ -0,0 +1,242 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type strategyDefinition struct {
+	Name        string                        `json:"name"`
+	Title       string                        `json:"title,omitempty"`
+	Description string                        `json:"description"`
+	Editable    bool                          `json:"editable,omitempty"`
+	Deprecated  bool                          `json:"deprecated,omitempty"`
+	Parameters  []strategyDefinitionParameter `json:"parameters"`
+}
+
+type strategyDefinitionParameter struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Description string `json:"description"`
+	Required    bool   `json:"required"`
+}
+
+type strategyDefinitions struct {
+	Strategies []strategyDefinition `json:"strategies"`
+}
+
+func resourceStrategy() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Provides a resource for managing unleash custom activation strategies.",
+
+		CreateContext: resourceStrategyCreate,
+		ReadContext:   resourceStrategyRead,
+		UpdateContext: resourceStrategyUpdate,
+		DeleteContext: resourceStrategyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: defaultTimeouts(),
+
+		// The descriptions are used by the documentation generator and the language server.
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Strategy unique name. Changing it forces a new resource to be created.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"title": {
+				Description: "A descriptive title for the strategy",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"description": {
+				Description: "Strategy description",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"deprecated": {
+				Description: "Whether the strategy is deprecated. Deprecated strategies can not be added to new features. Default is `false`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"parameter": {
+				Description: "Strategy parameter definition",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "Parameter name",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"type": {
+							Description:  "Parameter type. Can be `string`, `percentage`, `list`, `number` or `boolean`",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"string", "percentage", "list", "number", "boolean"}, false),
+						},
+						"description": {
+							Description: "Parameter description",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"required": {
+							Description: "Whether the parameter needs to be informed when the strategy is used. Default is `false`.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceStrategyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	strategy := toStrategyDefinition(d)
+
+	resp, err := adminRequest(ctx, client, http.MethodPost, "/api/admin/strategies", strategy, nil)
+	if resp == nil {
+		return diag.FromErr(fmt.Errorf("response is nil: %v", err))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strategy.Name)
+
+	if d.Get("deprecated").(bool) {
+		_, err := adminRequest(ctx, client, http.MethodPost, "/api/admin/strategies/"+url.PathEscape(d.Id())+"/deprecate", nil, nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	readDiags := resourceStrategyRead(ctx, d, meta)
+	if readDiags != nil {
+		diags = append(diags, readDiags...)
+	}
+
+	return diags
+}
+
+func resourceStrategyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	var strategy strategyDefinition
+	resp, err := adminRequest(ctx, client, http.MethodGet, "/api/admin/strategies/"+url.PathEscape(d.Id()), nil, &strategy)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("name", strategy.Name)
+	_ = d.Set("title", strategy.Title)
+	_ = d.Set("description", strategy.Description)
+	_ = d.Set("deprecated", strategy.Deprecated)
+	_ = d.Set("parameter", flattenStrategyDefinitionParameters(strategy.Parameters))
+
+	return diags
+}
+
+func resourceStrategyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	if d.HasChanges("title", "description", "parameter") {
+		resp, err := adminRequest(ctx, client, http.MethodPut, "/api/admin/strategies/"+url.PathEscape(d.Id()), toStrategyDefinition(d), nil)
+		if resp == nil {
+			return diag.FromErr(fmt.Errorf("response is nil: %v", err))
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("deprecated") {
+		action := "reactivate"
+		if d.Get("deprecated").(bool) {
+			action = "deprecate"
+		}
+		_, err := adminRequest(ctx, client, http.MethodPost, "/api/admin/strategies/"+url.PathEscape(d.Id())+"/"+action, nil, nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	readDiags := resourceStrategyRead(ctx, d, meta)
+	if readDiags != nil {
+		diags = append(diags, readDiags...)
+	}
+
+	return diags
+}
+
+func resourceStrategyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	_, err := adminRequest(ctx, client, http.MethodDelete, "/api/admin/strategies/"+url.PathEscape(d.Id()), nil, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return diags
+}
+
+func toStrategyDefinition(d *schema.ResourceData) strategyDefinition {
+	strategy := strategyDefinition{
+		Name:        d.Get("name").(string),
+		Title:       d.Get("title").(string),
+		Description: d.Get("description").(string),
+		Parameters:  []strategyDefinitionParameter{},
+	}
+	for _, tfParameter := range d.Get("parameter").([]interface{}) {
+		parameterMap := tfParameter.(map[string]interface{})
+		strategy.Parameters = append(strategy.Parameters, strategyDefinitionParameter{
+			Name:        parameterMap["name"].(string),
+			Type:        parameterMap["type"].(string),
+			Description: parameterMap["description"].(string),
+			Required:    parameterMap["required"].(bool),
+		})
+	}
+	return strategy
+}
+
+func flattenStrategyDefinitionParameters(parameters []strategyDefinitionParameter) []interface{} {
+	tfParameters := []interface{}{}
+	for _, parameter := range parameters {
+		tfParameter := map[string]interface{}{}
+		tfParameter["name"] = parameter.Name
+		tfParameter["type"] = parameter.Type
+		tfParameter["description"] = parameter.Description
+		tfParameter["required"] = parameter.Required
+		tfParameters = append(tfParameters, tfParameter)
+	}
+	return tfParameters
+}
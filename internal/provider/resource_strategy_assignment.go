@@ -20,6 +20,12 @@ func resourceStrategyAssignment() *schema.Resource {
 		UpdateContext: resourceStrategyAssignmentUpdate,
 		DeleteContext: resourceStrategyAssignmentDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceStrategyAssignmentImport,
+		},
+
+		Timeouts: defaultTimeouts(),
+
 		// The descriptions are used by the documentation generator and the language server.
 		Schema: map[string]*schema.Schema{
 			"feature_name": {
@@ -59,6 +65,15 @@ func resourceStrategyAssignment() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"constraint": constraintSchema(),
+			"segments": {
+				Description: "IDs of the segments the strategy uses",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
 			"variant": {
 				Description: "Feature strategy variant. The api returns them sorted by name, so if you see drifts, sort them by name when declaring them in the configuration as well.",
 				Type:        schema.TypeList,
@@ -116,7 +131,7 @@ func resourceStrategyAssignment() *schema.Resource {
 }
 
 func resourceStrategyAssignmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*ApiClients).PhilipsUnleashClient
+	client := meta.(*ApiClients).philipsClient(ctx)
 
 	var diags diag.Diagnostics
 
@@ -148,6 +163,14 @@ func resourceStrategyAssignmentCreate(ctx context.Context, d *schema.ResourceDat
 		featureStrategy.Parameters = convertedParams
 	}
 
+	if c, ok := d.GetOk("constraint"); ok {
+		featureStrategy.Constraints = toStrategyConstraints(c.([]interface{}))
+		err := validateConstraintContexts(ctx, meta.(*ApiClients).UnleashClient, featureStrategy.Constraints)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if p, ok := d.GetOk("variant"); ok {
 		tfVariants := p.([]interface{})
 		variants := make([]api.Variant, 0, len(tfVariants))
@@ -158,13 +181,21 @@ func resourceStrategyAssignmentCreate(ctx context.Context, d *schema.ResourceDat
 	}
 
 	addedStrategy, resp, err := client.FeatureToggles.AddStrategyToFeature(projectId, featureName, environment, *featureStrategy)
-	if resp == nil {
-		return diag.FromErr(fmt.Errorf("response is nil: %v", err))
-	}
 	if err != nil {
-		return diag.FromErr(err)
+		return environmentWriteDiags(projectId, environment, httpResponse(resp), err)
+	}
+	if resp == nil {
+		return diag.FromErr(fmt.Errorf("response is nil"))
 	}
 	d.SetId(addedStrategy.ID)
+
+	if s, ok := d.GetOk("segments"); ok {
+		err := setStrategySegments(ctx, meta.(*ApiClients).UnleashClient, projectId, environment, addedStrategy.ID, toSegmentIds(s.(*schema.Set)))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	readDiags := resourceStrategyAssignmentRead(ctx, d, meta)
 	if readDiags != nil {
 		diags = append(diags, readDiags...)
@@ -174,7 +205,7 @@ func resourceStrategyAssignmentCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceStrategyAssignmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*ApiClients).PhilipsUnleashClient
+	client := meta.(*ApiClients).philipsClient(ctx)
 
 	var diags diag.Diagnostics
 
@@ -190,10 +221,27 @@ func resourceStrategyAssignmentRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	strategyName := d.Get("strategy_name").(string)
 	environment := d.Get("environment").(string)
 
-	strategy, _, err := client.Strategies.GetStrategyByName(strategyName)
+	var foundStrategy *api.FeatureStrategy
+	for _, env := range feature.Environments {
+		if env.Name == environment {
+			for i, featureStrategy := range env.Strategies {
+				if featureStrategy.ID == d.Id() {
+					foundStrategy = &env.Strategies[i]
+					break
+				}
+			}
+			break
+		}
+	}
+	if foundStrategy == nil {
+		// the strategy was removed outside of terraform
+		d.SetId("")
+		return diags
+	}
+
+	strategy, _, err := client.Strategies.GetStrategyByName(foundStrategy.Name)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -201,29 +249,39 @@ func resourceStrategyAssignmentRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(api.ErrNotFound)
 	}
 
-	for _, env := range feature.Environments {
-		if env.Name == environment {
-			for _, featureStrategy := range env.Strategies {
-				if featureStrategy.Name == strategyName {
-					_ = d.Set("strategy_name", featureStrategy.Name)
-					convertedParams := make(map[string]interface{}) // convert actual param type back to string
-					retrievedParams := featureStrategy.Parameters
-					for _, param := range strategy.Parameters {
-						convertedParams[param.Name] = retrievedParams.(map[string]interface{})[param.Name].(string)
-					}
-					_ = d.Set("parameters", convertedParams)
-					_ = d.Set("variant", flattenVariants(featureStrategy.Variants))
-				}
-			}
-			break
-		}
+	_ = d.Set("strategy_name", foundStrategy.Name)
+	convertedParams := make(map[string]interface{}) // convert actual param type back to string
+	retrievedParams := foundStrategy.Parameters
+	for _, param := range strategy.Parameters {
+		convertedParams[param.Name] = retrievedParams.(map[string]interface{})[param.Name].(string)
+	}
+	_ = d.Set("parameters", convertedParams)
+	_ = d.Set("constraint", flattenConstraints(foundStrategy.Constraints))
+	_ = d.Set("variant", flattenVariants(foundStrategy.Variants))
+
+	segmentIds, err := getStrategySegments(ctx, meta.(*ApiClients).UnleashClient, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
 	}
+	_ = d.Set("segments", segmentIds)
 
 	return diags
 }
 
+func resourceStrategyAssignmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := parseImportId(d.Id(), "project/feature/environment/strategyId")
+	if err != nil {
+		return nil, err
+	}
+	_ = d.Set("project_id", parts[0])
+	_ = d.Set("feature_name", parts[1])
+	_ = d.Set("environment", parts[2])
+	d.SetId(parts[3])
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceStrategyAssignmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*ApiClients).PhilipsUnleashClient
+	client := meta.(*ApiClients).philipsClient(ctx)
 
 	var diags diag.Diagnostics
 
@@ -256,28 +314,40 @@ func resourceStrategyAssignmentUpdate(ctx context.Context, d *schema.ResourceDat
 		strategy.Parameters = convertedParams
 	}
 
-	if d.HasChange("variant") {
-		tfVariants := d.Get("variant").([]interface{})
-		variants := make([]api.Variant, 0, len(tfVariants))
-		for _, tfVariant := range tfVariants {
-			variants = append(variants, toFeatureVariant(tfVariant.(map[string]interface{})))
-		}
-		strategy.Variants = variants
+	// the update replaces the whole strategy, so the constraints and the variants are always sent
+	strategy.Constraints = toStrategyConstraints(d.Get("constraint").([]interface{}))
+	err := validateConstraintContexts(ctx, meta.(*ApiClients).UnleashClient, strategy.Constraints)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
+	tfVariants := d.Get("variant").([]interface{})
+	variants := make([]api.Variant, 0, len(tfVariants))
+	for _, tfVariant := range tfVariants {
+		variants = append(variants, toFeatureVariant(tfVariant.(map[string]interface{})))
+	}
+	strategy.Variants = variants
+
 	_, resp, err := client.FeatureToggles.UpdateFeatureStrategy(projectId, featureName, environment, *strategy)
+	if err != nil {
+		return environmentWriteDiags(projectId, environment, httpResponse(resp), err)
+	}
 	if resp == nil {
-		return diag.FromErr(fmt.Errorf("response is nil: %v", err))
+		return diag.FromErr(fmt.Errorf("response is nil"))
 	}
-	if err != nil {
-		return diag.FromErr(err)
+
+	if d.HasChange("segments") {
+		err := setStrategySegments(ctx, meta.(*ApiClients).UnleashClient, projectId, environment, d.Id(), toSegmentIds(d.Get("segments").(*schema.Set)))
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	return diags
 }
 
 func resourceStrategyAssignmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*ApiClients).PhilipsUnleashClient
+	client := meta.(*ApiClients).philipsClient(ctx)
 
 	var diags diag.Diagnostics
 
@@ -285,9 +355,9 @@ func resourceStrategyAssignmentDelete(ctx context.Context, d *schema.ResourceDat
 	featureName := d.Get("feature_name").(string)
 	projectId := d.Get("project_id").(string)
 	environment := d.Get("environment").(string)
-	_, _, err := client.FeatureToggles.DeleteStrategyFromFeature(projectId, featureName, environment, strategyId)
+	_, resp, err := client.FeatureToggles.DeleteStrategyFromFeature(projectId, featureName, environment, strategyId)
 	if err != nil {
-		return diag.FromErr(err)
+		return environmentWriteDiags(projectId, environment, httpResponse(resp), err)
 	}
 	d.SetId("")
 	return diags
@@ -10,12 +10,14 @@ import (
 )
 
 func TestAccResourceStrategyAssignment(t *testing.T) {
+	randomSuffix := utils.RandomString(4)
+
 	resource.UnitTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: providerFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccResourceStrategyAssignment,
+				Config: testAccResourceStrategyAssignment(randomSuffix, ""),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestMatchResourceAttr("unleash_strategy_assignment.foo", "feature_name", regexp.MustCompile("^bar")),
 					resource.TestCheckResourceAttr("unleash_strategy_assignment.foo", "project_id", "default"),
@@ -32,13 +34,45 @@ func TestAccResourceStrategyAssignment(t *testing.T) {
 					resource.TestCheckResourceAttr("unleash_strategy_assignment.foo", "variant.1.payload.0.value", "foo"),
 					resource.TestCheckResourceAttr("unleash_strategy_assignment.foo2", "strategy_name", "remoteAddress"),
 					resource.TestCheckResourceAttr("unleash_strategy_assignment.foo2", "parameters.IPs", "xyz,bar"),
+					resource.TestCheckResourceAttr("unleash_strategy_assignment.foo2", "constraint.0.context_name", "appName"),
+					resource.TestCheckResourceAttr("unleash_strategy_assignment.foo2", "constraint.0.operator", "IN"),
+					resource.TestCheckResourceAttr("unleash_strategy_assignment.foo2", "constraint.0.values.#", "2"),
+					// strategies with the same name are told apart by their id
+					resource.TestCheckResourceAttr("unleash_strategy_assignment.foo3", "strategy_name", "flexibleRollout"),
+					resource.TestCheckResourceAttr("unleash_strategy_assignment.foo3", "parameters.rollout", "25"),
 				),
 			},
+			{
+				// Changing only a constraint keeps the variants
+				Config: testAccResourceStrategyAssignment(randomSuffix, testAccResourceStrategyAssignmentConstraint),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_strategy_assignment.foo", "constraint.0.context_name", "appName"),
+					resource.TestCheckResourceAttr("unleash_strategy_assignment.foo", "constraint.0.operator", "IN"),
+					resource.TestCheckResourceAttr("unleash_strategy_assignment.foo", "variant.#", "2"),
+					resource.TestCheckResourceAttr("unleash_strategy_assignment.foo", "variant.0.name", "a"),
+					resource.TestCheckResourceAttr("unleash_strategy_assignment.foo", "variant.1.name", "b"),
+					resource.TestCheckResourceAttr("unleash_strategy_assignment.foo", "variant.1.weight", "500"),
+				),
+			},
+			{
+				ResourceName:      "unleash_strategy_assignment.foo",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIdFunc("unleash_strategy_assignment.foo", "project_id", "feature_name", "environment", "id"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
-var testAccResourceStrategyAssignment = fmt.Sprintf(`
+const testAccResourceStrategyAssignmentConstraint = `
+	constraint {
+	  context_name = "appName"
+	  operator     = "IN"
+	  values       = ["foo"]
+	}`
+
+func testAccResourceStrategyAssignment(suffix string, fooConstraint string) string {
+	return fmt.Sprintf(`
 resource "unleash_feature" "foo" {
   name = "bar%s"
   project_id = "default"
@@ -53,7 +87,7 @@ resource "unleash_strategy_assignment" "foo" {
 	  rollout    = "68"
 	  stickiness = "random"
 	  groupId    = "toggle"
-	}
+	}%s
   variant {
 		name = "a"
 		payload {
@@ -79,5 +113,22 @@ resource "unleash_strategy_assignment" "foo2" {
 	parameters = {
 	  IPs    = "xyz,bar"
 	}
+	constraint {
+	  context_name = "appName"
+	  operator     = "IN"
+	  values       = ["foo", "bar"]
+	}
+}
+resource "unleash_strategy_assignment" "foo3" {
+	feature_name  = unleash_feature.foo.name
+	project_id    = "default"
+	environment   = "development"
+	strategy_name = "flexibleRollout"
+	parameters = {
+	  rollout    = "25"
+	  stickiness = "default"
+	  groupId    = "toggle"
+	}
+}
+`, suffix, fooConstraint)
 }
-`, utils.RandomString(4))
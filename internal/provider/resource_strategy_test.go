@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/philips-labs/terraform-provider-unleash/utils"
+)
+
+func TestAccResourceStrategy(t *testing.T) {
+	randomSuffix := utils.RandomString(4)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// Initial creation
+				Config: testAccResourceStrategyInitial(randomSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_strategy.foo", "name", "tenant"+randomSuffix),
+					resource.TestCheckResourceAttr("unleash_strategy.foo", "title", "Tenant"),
+					resource.TestCheckResourceAttr("unleash_strategy.foo", "deprecated", "false"),
+					resource.TestCheckResourceAttr("unleash_strategy.foo", "parameter.#", "1"),
+					resource.TestCheckResourceAttr("unleash_strategy.foo", "parameter.0.name", "tenants"),
+					resource.TestCheckResourceAttr("unleash_strategy.foo", "parameter.0.type", "list"),
+					resource.TestCheckResourceAttr("unleash_strategy.foo", "parameter.0.required", "true"),
+				),
+			},
+			{
+				// Update configuration
+				Config: testAccResourceStrategyUpdated(randomSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updates took effect
+					resource.TestCheckResourceAttr("unleash_strategy.foo", "description", "tenant based rollout"),
+					resource.TestCheckResourceAttr("unleash_strategy.foo", "deprecated", "true"),
+					resource.TestCheckResourceAttr("unleash_strategy.foo", "parameter.#", "2"),
+					resource.TestCheckResourceAttr("unleash_strategy.foo", "parameter.1.name", "rollout"),
+					resource.TestCheckResourceAttr("unleash_strategy.foo", "parameter.1.type", "percentage"),
+					// Verify unchanged attributes
+					resource.TestCheckResourceAttr("unleash_strategy.foo", "name", "tenant"+randomSuffix),
+				),
+			},
+			{
+				ResourceName:      "unleash_strategy.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceStrategyInitial(suffix string) string {
+	return fmt.Sprintf(`
+resource "unleash_strategy" "foo" {
+  name  = "tenant%s"
+  title = "Tenant"
+
+  parameter {
+    name     = "tenants"
+    type     = "list"
+    required = true
+  }
+}`, suffix)
+}
+
+func testAccResourceStrategyUpdated(suffix string) string {
+	return fmt.Sprintf(`
+resource "unleash_strategy" "foo" {
+  name        = "tenant%s"
+  title       = "Tenant"
+  description = "tenant based rollout"
+  deprecated  = true
+
+  parameter {
+    name     = "tenants"
+    type     = "list"
+    required = true
+  }
+  parameter {
+    name = "rollout"
+    type = "percentage"
+  }
+}`, suffix)
+}
@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type tagType struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description"`
+	Icon        string `json:"icon,omitempty"`
+}
+
+type tagTypeResponse struct {
+	TagType tagType `json:"tagType"`
+}
+
+func resourceTagType() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Provides a resource for managing unleash tag types.",
+
+		CreateContext: resourceTagTypeCreate,
+		ReadContext:   resourceTagTypeRead,
+		UpdateContext: resourceTagTypeUpdate,
+		DeleteContext: resourceTagTypeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: defaultTimeouts(),
+
+		// The descriptions are used by the documentation generator and the language server.
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description:  "The tag type name. Changing it forces a new resource to be created.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9~_.-]+$`), "must be a URL-friendly string"),
+			},
+			"description": {
+				Description: "The tag type description.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"icon": {
+				Description: "The icon shown next to the tags of this type.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func resourceTagTypeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	newTagType := tagType{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Icon:        d.Get("icon").(string),
+	}
+
+	resp, err := adminRequest(ctx, client, http.MethodPost, "/api/admin/tag-types", newTagType, nil)
+	if resp == nil {
+		return diag.FromErr(fmt.Errorf("response is nil: %v", err))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(newTagType.Name)
+	readDiags := resourceTagTypeRead(ctx, d, meta)
+	if readDiags != nil {
+		diags = append(diags, readDiags...)
+	}
+
+	return diags
+}
+
+func resourceTagTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	var found tagTypeResponse
+	resp, err := adminRequest(ctx, client, http.MethodGet, "/api/admin/tag-types/"+url.PathEscape(d.Id()), nil, &found)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("name", found.TagType.Name)
+	_ = d.Set("description", found.TagType.Description)
+	_ = d.Set("icon", found.TagType.Icon)
+
+	return diags
+}
+
+func resourceTagTypeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	updatedTagType := tagType{
+		Description: d.Get("description").(string),
+		Icon:        d.Get("icon").(string),
+	}
+
+	resp, err := adminRequest(ctx, client, http.MethodPut, "/api/admin/tag-types/"+url.PathEscape(d.Id()), updatedTagType, nil)
+	if resp == nil {
+		return diag.FromErr(fmt.Errorf("response is nil: %v", err))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	readDiags := resourceTagTypeRead(ctx, d, meta)
+	if readDiags != nil {
+		diags = append(diags, readDiags...)
+	}
+
+	return diags
+}
+
+func resourceTagTypeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ApiClients).UnleashClient
+
+	var diags diag.Diagnostics
+
+	_, err := adminRequest(ctx, client, http.MethodDelete, "/api/admin/tag-types/"+url.PathEscape(d.Id()), nil, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return diags
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/philips-labs/terraform-provider-unleash/utils"
+)
+
+func TestAccResourceTagType(t *testing.T) {
+	randomSuffix := utils.RandomString(4)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				// Initial creation
+				Config: testAccResourceTagTypeInitial(randomSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_tag_type.foo", "name", "team"+randomSuffix),
+					resource.TestCheckResourceAttr("unleash_tag_type.foo", "description", "owning team"),
+					resource.TestCheckResourceAttr("unleash_feature_v2.foo", "tag.0.type", "team"+randomSuffix),
+				),
+			},
+			{
+				// Update configuration
+				Config: testAccResourceTagTypeUpdated(randomSuffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updates took effect
+					resource.TestCheckResourceAttr("unleash_tag_type.foo", "description", "the team owning the feature"),
+					resource.TestCheckResourceAttr("data.unleash_tag.foo", "values.#", "1"),
+					resource.TestCheckResourceAttr("data.unleash_tag.foo", "values.0", "payments"),
+					// Verify unchanged attributes
+					resource.TestCheckResourceAttr("unleash_tag_type.foo", "name", "team"+randomSuffix),
+				),
+			},
+			{
+				ResourceName:      "unleash_tag_type.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceTagTypeInitial(suffix string) string {
+	return fmt.Sprintf(`
+resource "unleash_tag_type" "foo" {
+  name        = "team%s"
+  description = "owning team"
+}
+resource "unleash_feature_v2" "foo" {
+  name               = "bar%s"
+  type               = "release"
+  project_id         = "default"
+  archive_on_destroy = false
+
+  tag {
+    type  = unleash_tag_type.foo.name
+    value = "payments"
+  }
+}`, suffix, suffix)
+}
+
+func testAccResourceTagTypeUpdated(suffix string) string {
+	return fmt.Sprintf(`
+resource "unleash_tag_type" "foo" {
+  name        = "team%s"
+  description = "the team owning the feature"
+}
+resource "unleash_feature_v2" "foo" {
+  name               = "bar%s"
+  type               = "release"
+  project_id         = "default"
+  archive_on_destroy = false
+
+  tag {
+    type  = unleash_tag_type.foo.name
+    value = "payments"
+  }
+}
+data "unleash_tag" "foo" {
+  type = unleash_tag_type.foo.name
+
+  depends_on = [unleash_feature_v2.foo]
+}`, suffix, suffix)
+}
@@ -22,6 +22,12 @@ func resourceUser() *schema.Resource {
 		UpdateContext: resourceUserUpdate,
 		DeleteContext: resourceUserDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUserImport,
+		},
+
+		Timeouts: defaultTimeouts(),
+
 		// The descriptions are used by the documentation generator and the language server.
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -42,10 +48,10 @@ func resourceUser() *schema.Resource {
 				ForceNew:    true,
 			},
 			"root_role": {
-				Description:  "The role to assign to the user. Can be `Admin`, `Editor` or `Viewer`",
+				Description:  "The root role to assign to the user. Can be the name of a role, such as `Admin`, `Editor` or `Viewer`, or its id.",
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validation.StringInSlice([]string{"Admin", "Editor", "Viewer"}, false),
+				ValidateFunc: validation.StringIsNotEmpty,
 			},
 			"send_email": {
 				Description: "Whether to send a welcome email with a login link to the user or not. Defaults to `true`.",
@@ -72,13 +78,20 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interf
 
 	var diags diag.Diagnostics
 
-	givenUserRole := d.Get("root_role").(string)
+	roles, err := getRoles(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	givenUserRole, err := findRole(roles, d.Get("root_role").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	givenName := d.Get("name").(string)
 	givenEmail := d.Get("email").(string)
 	givenUsername := d.Get("username").(string)
 	givenSendEmail := d.Get("send_email").(bool)
 
-	createUserSchema := *openapiclient.NewCreateUserSchema(openapiclient.CreateUserSchemaRootRole{String: &givenUserRole})
+	createUserSchema := *openapiclient.NewCreateUserSchema(openapiclient.CreateUserSchemaRootRole{Int32: &givenUserRole.Id})
 	createUserSchema.Name = &givenName
 	createUserSchema.Email = &givenEmail
 	createUserSchema.Username = &givenUsername
@@ -125,24 +138,37 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	_ = d.Set("name", user.Name.Get())
 	_ = d.Set("email", user.Email)
 
-	for k, v := range rolesLookup {
-		if int32(v) == *user.RootRole {
-			_ = d.Set("root_role", k)
-		}
+	rootRole, err := flattenRootRole(ctx, client, d.Get("root_role").(string), user.GetRootRole())
+	if err != nil {
+		return diag.FromErr(err)
 	}
+	_ = d.Set("root_role", rootRole)
 
 	return diags
 }
 
+func resourceUserImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// the welcome email is only sent on creation
+	_ = d.Set("send_email", true)
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*ApiClients).UnleashClient
 
 	var diags diag.Diagnostics
 
-	givenUserRole := d.Get("root_role").(string)
+	roles, err := getRoles(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	givenUserRole, err := findRole(roles, d.Get("root_role").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	givenName := d.Get("name").(string)
 	givenEmail := d.Get("email").(string)
-	rootRole := openapiclient.StringAsCreateUserSchemaRootRole(&givenUserRole)
+	rootRole := openapiclient.Int32AsCreateUserSchemaRootRole(&givenUserRole.Id)
 
 	updateUserSchema := *openapiclient.NewUpdateUserSchema()
 	updateUserSchema.Name = &givenName
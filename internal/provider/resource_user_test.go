@@ -38,6 +38,12 @@ func TestAccResourceUser(t *testing.T) {
 					resource.TestMatchResourceAttr("unleash_user.foo", "username", regexp.MustCompile("^xyz")),
 				),
 			},
+			{
+				ResourceName:            "unleash_user.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"send_email", "invite_link", "email_sent"},
+			},
 		},
 	})
 }
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	openapiclient "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	editionOpenSource = "oss"
+	editionPro        = "pro"
+	editionEnterprise = "enterprise"
+)
+
+// ServerInfo is the version and edition of the unleash instance the provider talks to.
+type ServerInfo struct {
+	Version string
+	Edition string
+	Major   int
+	Minor   int
+	// Known is false when the version could not be detected, in which case the newest API is assumed.
+	Known bool
+}
+
+type uiConfig struct {
+	Version     string `json:"version"`
+	Environment string `json:"environment"`
+	VersionInfo struct {
+		Current struct {
+			Oss        string `json:"oss"`
+			Enterprise string `json:"enterprise"`
+		} `json:"current"`
+	} `json:"versionInfo"`
+}
+
+// detectServer reads the version and edition of the unleash instance from its UI config.
+func detectServer(ctx context.Context, client *openapiclient.APIClient) (ServerInfo, error) {
+	var config uiConfig
+	_, err := adminRequest(ctx, client, http.MethodGet, "/api/admin/ui-config", nil, &config)
+	if err != nil {
+		return ServerInfo{}, err
+	}
+
+	// Same rules as the unleash UI: only the paid editions have an enterprise version, and they tell which one they are
+	edition := editionOpenSource
+	if config.VersionInfo.Current.Enterprise != "" {
+		edition = editionEnterprise
+		if strings.EqualFold(config.Environment, editionPro) {
+			edition = editionPro
+		}
+	}
+
+	version := config.Version
+	if version == "" {
+		version = config.VersionInfo.Current.Oss
+	}
+	major, minor, ok := parseServerVersion(version)
+	return ServerInfo{
+		Version: version,
+		Edition: edition,
+		Major:   major,
+		Minor:   minor,
+		Known:   ok,
+	}, nil
+}
+
+// parseServerVersion reads the major and minor version of versions such as `5.7.0`, `v4.22.1` or `5.8.0-beta.1`.
+func parseServerVersion(version string) (int, int, bool) {
+	parts := strings.SplitN(strings.TrimPrefix(version, "v"), ".", 3)
+	if len(parts) < 2 {
+		return 0, 0, false
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, false
+	}
+	minor, err := strconv.Atoi(strings.SplitN(parts[1], "-", 2)[0])
+	if err != nil {
+		return 0, 0, false
+	}
+	return major, minor, true
+}
+
+// AtLeast tells whether the instance runs the given version or a newer one. It is true when the version is not known.
+func (s ServerInfo) AtLeast(major int, minor int) bool {
+	if !s.Known {
+		return true
+	}
+	return s.Major > major || (s.Major == major && s.Minor >= minor)
+}
+
+func (s ServerInfo) String() string {
+	return editionName(s.Edition) + " " + s.Version
+}
+
+func editionName(edition string) string {
+	switch edition {
+	case editionEnterprise:
+		return "Unleash Enterprise"
+	case editionPro:
+		return "Unleash Pro"
+	}
+	return "Unleash Open Source"
+}
+
+// requireVersion returns an error when the instance is known to be older than the version the feature needs.
+func (s ServerInfo) requireVersion(feature string, major int, minor int) error {
+	if s.AtLeast(major, minor) {
+		return nil
+	}
+	return fmt.Errorf("%w: %s requires Unleash %d.%d or later, but the instance runs %s", ErrUnsupportedByServer, feature, major, minor, s)
+}
+
+// requireEdition returns an error when the instance is known to be of another edition than the ones the feature is
+// available in.
+func (s ServerInfo) requireEdition(feature string, editions ...string) error {
+	if !s.Known || contains(editions, s.Edition) {
+		return nil
+	}
+	names := make([]string, 0, len(editions))
+	for _, edition := range editions {
+		names = append(names, editionName(edition))
+	}
+	return fmt.Errorf("%w: %s is only available in %s, but the instance runs %s", ErrUnsupportedByServer, feature, strings.Join(names, " or "), s)
+}
+
+// serverInfo returns the detected server of the provider. Plans can run before the provider is configured, in which
+// case nothing is known yet.
+func serverInfo(meta interface{}) ServerInfo {
+	clients, ok := meta.(*ApiClients)
+	if !ok || clients == nil {
+		return ServerInfo{}
+	}
+	return clients.Server
+}
+
+// requireServerDiff fails the plan of a resource that the unleash instance does not support, rather than letting the
+// apply fail on an unknown endpoint.
+func requireServerDiff(feature string, major int, minor int, editions ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		server := serverInfo(meta)
+		if err := server.requireVersion(feature, major, minor); err != nil {
+			return err
+		}
+		if len(editions) == 0 {
+			return nil
+		}
+		return server.requireEdition(feature, editions...)
+	}
+}
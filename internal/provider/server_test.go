@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	openapiclient "github.com/Unleash/unleash-server-api-go/client"
+)
+
+func TestParseServerVersion(t *testing.T) {
+	tests := []struct {
+		version   string
+		wantMajor int
+		wantMinor int
+		wantOk    bool
+	}{
+		{"5.7.0", 5, 7, true},
+		{"v4.22.1", 4, 22, true},
+		{"5.8.0-beta.1", 5, 8, true},
+		{"5.8-beta.1", 5, 8, true},
+		{"6.0", 6, 0, true},
+		{"", 0, 0, false},
+		{"5", 0, 0, false},
+		{"v", 0, 0, false},
+		{"five.seven.0", 0, 0, false},
+		{"5.x.0", 0, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			major, minor, ok := parseServerVersion(tt.version)
+			if major != tt.wantMajor || minor != tt.wantMinor || ok != tt.wantOk {
+				t.Errorf("parseServerVersion(%q) = %d, %d, %t, want %d, %d, %t", tt.version, major, minor, ok, tt.wantMajor, tt.wantMinor, tt.wantOk)
+			}
+		})
+	}
+}
+
+// newTestUnleashClient returns an unleash client of a server that answers the UI config with the given fixture.
+func newTestUnleashClient(t *testing.T, status int, uiConfig string) *openapiclient.APIClient {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/admin/ui-config" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		fmt.Fprint(w, uiConfig)
+	}))
+	t.Cleanup(server.Close)
+
+	config := openapiclient.NewConfiguration()
+	config.Servers = openapiclient.ServerConfigurations{
+		openapiclient.ServerConfiguration{URL: server.URL},
+	}
+	config.HTTPClient = server.Client()
+	return openapiclient.NewAPIClient(config)
+}
+
+func TestDetectServer(t *testing.T) {
+	tests := []struct {
+		name     string
+		uiConfig string
+		want     ServerInfo
+	}{
+		{
+			name:     "open source",
+			uiConfig: `{"version":"5.7.0","versionInfo":{"current":{"oss":"5.7.0"}}}`,
+			want:     ServerInfo{Version: "5.7.0", Edition: editionOpenSource, Major: 5, Minor: 7, Known: true},
+		},
+		{
+			name:     "enterprise",
+			uiConfig: `{"version":"5.8.0-beta.1","environment":"Enterprise","versionInfo":{"current":{"oss":"5.8.0","enterprise":"5.8.0-beta.1"}}}`,
+			want:     ServerInfo{Version: "5.8.0-beta.1", Edition: editionEnterprise, Major: 5, Minor: 8, Known: true},
+		},
+		{
+			name:     "pro",
+			uiConfig: `{"version":"v4.22.1","environment":"Pro","versionInfo":{"current":{"oss":"4.22.1","enterprise":"4.22.1"}}}`,
+			want:     ServerInfo{Version: "v4.22.1", Edition: editionPro, Major: 4, Minor: 22, Known: true},
+		},
+		{
+			name:     "version from the version info",
+			uiConfig: `{"versionInfo":{"current":{"oss":"4.22.1"}}}`,
+			want:     ServerInfo{Version: "4.22.1", Edition: editionOpenSource, Major: 4, Minor: 22, Known: true},
+		},
+		{
+			name:     "no version",
+			uiConfig: `{}`,
+			want:     ServerInfo{Edition: editionOpenSource},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestUnleashClient(t, http.StatusOK, tt.uiConfig)
+			got, err := detectServer(context.Background(), client)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("detectServer() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("forbidden", func(t *testing.T) {
+		client := newTestUnleashClient(t, http.StatusForbidden, `{"message":"forbidden"}`)
+		if _, err := detectServer(context.Background(), client); err == nil {
+			t.Error("expected an error when the UI config can not be read")
+		}
+	})
+}
+
+func TestServerInfoRequirements(t *testing.T) {
+	enterprise := ServerInfo{Version: "5.7.0", Edition: editionEnterprise, Major: 5, Minor: 7, Known: true}
+	openSource := ServerInfo{Version: "4.22.1", Edition: editionOpenSource, Major: 4, Minor: 22, Known: true}
+	unknown := ServerInfo{}
+
+	tests := []struct {
+		name     string
+		server   ServerInfo
+		major    int
+		minor    int
+		editions []string
+		wantErr  bool
+	}{
+		{"same version", enterprise, 5, 7, nil, false},
+		{"older minor required", enterprise, 5, 6, nil, false},
+		{"older major required", enterprise, 4, 30, nil, false},
+		{"newer minor required", enterprise, 5, 8, nil, true},
+		{"newer major required", openSource, 5, 0, nil, true},
+		{"unknown version", unknown, 99, 0, nil, false},
+		{"edition available", enterprise, 4, 0, []string{editionPro, editionEnterprise}, false},
+		{"edition not available", openSource, 4, 0, []string{editionEnterprise}, true},
+		{"unknown edition", unknown, 4, 0, []string{editionEnterprise}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := requireServerDiff("the feature", tt.major, tt.minor, tt.editions...)(context.Background(), nil, &ApiClients{Server: tt.server})
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrUnsupportedByServer) {
+				t.Errorf("error = %v, want %v", err, ErrUnsupportedByServer)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package provider
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	defaultReadTimeout  = 5 * time.Minute
+	defaultWriteTimeout = 10 * time.Minute
+)
+
+// defaultTimeouts bound the operations of a resource, including the retries of their requests, so that an unleash that
+// does not answer can not block terraform forever. They can be changed with the timeouts block of the resource.
+func defaultTimeouts() *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(defaultWriteTimeout),
+		Read:   schema.DefaultTimeout(defaultReadTimeout),
+		Update: schema.DefaultTimeout(defaultWriteTimeout),
+		Delete: schema.DefaultTimeout(defaultWriteTimeout),
+	}
+}
@@ -0,0 +1,257 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// transportOptions are the connection settings of the provider that apply to the requests of both unleash clients.
+type transportOptions struct {
+	caCert             string
+	clientCert         string
+	clientKey          string
+	insecureSkipVerify bool
+	proxyUrl           string
+	headers            map[string]string
+}
+
+// newBaseTransport builds the transport that connects to unleash, with the TLS and proxy settings and the extra headers.
+func newBaseTransport(options transportOptions) (http.RoundTripper, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: options.insecureSkipVerify,
+	}
+	if options.caCert != "" {
+		caCert, err := readPem(options.caCert)
+		if err != nil {
+			return nil, fmt.Errorf("reading ca_cert: %w", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("ca_cert does not contain any PEM encoded certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if options.clientCert != "" || options.clientKey != "" {
+		clientCert, err := readPem(options.clientCert)
+		if err != nil {
+			return nil, fmt.Errorf("reading client_cert: %w", err)
+		}
+		clientKey, err := readPem(options.clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("reading client_key: %w", err)
+		}
+		certificate, err := tls.X509KeyPair(clientCert, clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("loading the client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	if options.proxyUrl != "" {
+		proxyUrl, err := url.Parse(options.proxyUrl)
+		if err != nil {
+			return nil, fmt.Errorf("parsing proxy_url: %w", err)
+		}
+		transport.Proxy = http.ProxyURL(proxyUrl)
+	}
+
+	if len(options.headers) == 0 {
+		return transport, nil
+	}
+	return &headerTransport{next: transport, headers: options.headers}, nil
+}
+
+// readPem returns the given PEM content, or reads it from the file when given a path.
+func readPem(value string) ([]byte, error) {
+	if strings.Contains(value, "-----BEGIN") {
+		return []byte(value), nil
+	}
+	return os.ReadFile(value)
+}
+
+// headerTransport adds headers to every request, such as the ones a reverse proxy in front of unleash requires.
+type headerTransport struct {
+	next    http.RoundTripper
+	headers map[string]string
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	for k, v := range t.headers {
+		req.Header.Set(k, v)
+	}
+	return t.next.RoundTrip(req)
+}
+
+// contextTransport binds the requests to a context, for the clients that do not take one.
+type contextTransport struct {
+	ctx  context.Context
+	next http.RoundTripper
+}
+
+func (t *contextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.next.RoundTrip(req.WithContext(t.ctx))
+}
+
+// retryTransport retries the requests that unleash rate limits with HTTP 429, waiting between the attempts with an
+// exponential backoff with jitter, or as long as the Retry-After header asks. Connection errors and 5xx responses are
+// also retried, but only for idempotent methods, as the server may have applied the request already.
+type retryTransport struct {
+	next       http.RoundTripper
+	maxRetries int
+	minWait    time.Duration
+	maxWait    time.Duration
+	// timeout limits every attempt separately. Zero means no limit.
+	timeout time.Duration
+}
+
+func newRetryTransport(next http.RoundTripper, maxRetries int, minWait time.Duration, maxWait time.Duration, timeout time.Duration) *retryTransport {
+	return &retryTransport{
+		next:       next,
+		maxRetries: maxRetries,
+		minWait:    minWait,
+		maxWait:    maxWait,
+		timeout:    timeout,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		attemptReq, cancel, err := t.attemptRequest(req, attempt)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := t.next.RoundTrip(attemptReq)
+		if attempt >= t.maxRetries || !t.shouldRetry(req, resp, err) || (req.Body != nil && req.GetBody == nil) {
+			if err != nil {
+				cancel()
+				return nil, err
+			}
+			resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+			return resp, nil
+		}
+
+		wait := t.backoff(attempt, resp)
+		if resp != nil {
+			// Drains the body so the connection can be reused
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		cancel()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// attemptRequest prepares the request for an attempt, with a fresh body and the per attempt timeout.
+func (t *retryTransport) attemptRequest(req *http.Request, attempt int) (*http.Request, context.CancelFunc, error) {
+	ctx, cancel := req.Context(), context.CancelFunc(func() {})
+	if t.timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, t.timeout)
+	}
+	attemptReq := req.Clone(ctx)
+	if attempt > 0 && req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			cancel()
+			return nil, nil, err
+		}
+		attemptReq.Body = body
+	}
+	return attemptReq, cancel, nil
+}
+
+func (t *retryTransport) shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	if !isIdempotent(req.Method) {
+		return false
+	}
+	return err != nil || resp.StatusCode >= http.StatusInternalServerError
+}
+
+// backoff returns how long to wait before the next attempt. It doubles with every attempt, up to the maximum wait,
+// with a random jitter so that concurrent requests don't retry at the same time. A longer Retry-After takes precedence,
+// but it is limited to the maximum wait as well.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	wait := t.minWait
+	for i := 0; i < attempt && wait < t.maxWait; i++ {
+		wait *= 2
+	}
+	if wait > t.maxWait {
+		wait = t.maxWait
+	}
+	wait = wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+
+	if resp != nil {
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok && retryAfter > wait {
+			wait = retryAfter
+		}
+	}
+	if wait > t.maxWait {
+		wait = t.maxWait
+	}
+	return wait
+}
+
+// parseRetryAfter reads a Retry-After header, given either in seconds or as an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second, seconds >= 0
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return time.Until(date), true
+	}
+	return 0, false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// cancelOnClose releases the context of an attempt once its response body is closed, as canceling it before would
+// abort reading the body.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+	return err
+}
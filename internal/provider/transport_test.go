@@ -0,0 +1,370 @@
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testServer answers with the given statuses in turn, repeating the last one, and records the bodies it receives.
+type testServer struct {
+	*httptest.Server
+	mu       sync.Mutex
+	statuses []int
+	headers  http.Header
+	bodies   []string
+}
+
+func newTestServer(t *testing.T, statuses ...int) *testServer {
+	s := &testServer{statuses: statuses, headers: http.Header{}}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		s.mu.Lock()
+		status := s.statuses[min(len(s.bodies), len(s.statuses)-1)]
+		s.bodies = append(s.bodies, string(body))
+		s.mu.Unlock()
+		for k, v := range s.headers {
+			w.Header()[k] = v
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *testServer) attempts() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.bodies)
+}
+
+func TestRetryTransportRetries(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		statuses     []int
+		maxRetries   int
+		wantAttempts int
+		wantStatus   int
+	}{
+		{"success", http.MethodGet, []int{200}, 3, 1, 200},
+		{"rate limited get", http.MethodGet, []int{429, 429, 200}, 3, 3, 200},
+		{"rate limited post", http.MethodPost, []int{429, 200}, 3, 2, 200},
+		{"server error get", http.MethodGet, []int{500, 503, 200}, 3, 3, 200},
+		{"server error put", http.MethodPut, []int{502, 200}, 3, 2, 200},
+		{"server error delete", http.MethodDelete, []int{500, 200}, 3, 2, 200},
+		{"server error post", http.MethodPost, []int{500, 200}, 3, 1, 500},
+		{"server error patch", http.MethodPatch, []int{500, 200}, 3, 1, 500},
+		{"client error", http.MethodGet, []int{404, 200}, 3, 1, 404},
+		{"retries exhausted", http.MethodGet, []int{429}, 2, 3, 429},
+		{"no retries", http.MethodGet, []int{429, 200}, 0, 1, 429},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newTestServer(t, tt.statuses...)
+			client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, tt.maxRetries, time.Millisecond, 5*time.Millisecond, 0)}
+
+			req, err := http.NewRequest(tt.method, server.URL, strings.NewReader("payload"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			if got := server.attempts(); got != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", got, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+// errorTransport fails every request, like a server that can not be connected to.
+type errorTransport struct {
+	attempts int
+}
+
+func (t *errorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.attempts++
+	return nil, errors.New("connection refused")
+}
+
+func TestRetryTransportConnectionErrors(t *testing.T) {
+	tests := []struct {
+		method       string
+		wantAttempts int
+	}{
+		{http.MethodGet, 3},
+		{http.MethodHead, 3},
+		{http.MethodPut, 3},
+		{http.MethodDelete, 3},
+		{http.MethodPost, 1},
+		{http.MethodPatch, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			next := &errorTransport{}
+			transport := newRetryTransport(next, 2, time.Millisecond, time.Millisecond, 0)
+
+			req, err := http.NewRequest(tt.method, "http://unleash.invalid", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = transport.RoundTrip(req)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if next.attempts != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", next.attempts, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestRetryTransportRewindsBody(t *testing.T) {
+	server := newTestServer(t, 429, 429, 201)
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, 3, time.Millisecond, time.Millisecond, 0)}
+
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"name":"foo"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != 201 {
+		t.Errorf("status = %d, want 201", resp.StatusCode)
+	}
+	if len(server.bodies) != 3 {
+		t.Fatalf("attempts = %d, want 3", len(server.bodies))
+	}
+	for i, body := range server.bodies {
+		if body != `{"name":"foo"}` {
+			t.Errorf("body of attempt %d = %q", i+1, body)
+		}
+	}
+}
+
+func TestRetryTransportHonorsRetryAfter(t *testing.T) {
+	server := newTestServer(t, 429, 200)
+	server.headers.Set("Retry-After", "1")
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, 1, time.Millisecond, 2*time.Second, 0)}
+
+	start := time.Now()
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("retried after %s, want at least the 1s of Retry-After", elapsed)
+	}
+}
+
+func TestRetryTransportTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	t.Cleanup(server.Close)
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, 1, time.Millisecond, time.Millisecond, 20*time.Millisecond)}
+
+	start := time.Now()
+	_, err := client.Get(server.URL)
+	if err == nil {
+		t.Fatal("expected a timeout")
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("gave up after %s, want the attempts to time out", elapsed)
+	}
+}
+
+func TestRetryTransportBackoff(t *testing.T) {
+	tests := []struct {
+		name       string
+		attempt    int
+		minWait    time.Duration
+		maxWait    time.Duration
+		retryAfter string
+		wantMin    time.Duration
+		wantMax    time.Duration
+	}{
+		{"first retry", 0, time.Second, 30 * time.Second, "", 500 * time.Millisecond, time.Second},
+		{"doubles", 2, time.Second, 30 * time.Second, "", 2 * time.Second, 4 * time.Second},
+		{"capped", 10, time.Second, 30 * time.Second, "", 15 * time.Second, 30 * time.Second},
+		{"large attempt", 100, time.Second, 30 * time.Second, "", 15 * time.Second, 30 * time.Second},
+		{"no wait", 3, 0, 0, "", 0, 0},
+		{"longer retry after", 0, time.Second, 30 * time.Second, "10", 10 * time.Second, 10 * time.Second},
+		{"shorter retry after", 3, time.Second, 30 * time.Second, "1", 4 * time.Second, 8 * time.Second},
+		{"retry after capped", 0, time.Second, 30 * time.Second, "3600", 30 * time.Second, 30 * time.Second},
+		{"invalid retry after", 0, time.Second, 30 * time.Second, "soon", 500 * time.Millisecond, time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transport := newRetryTransport(http.DefaultTransport, 3, tt.minWait, tt.maxWait, 0)
+			resp := &http.Response{Header: http.Header{}}
+			if tt.retryAfter != "" {
+				resp.Header.Set("Retry-After", tt.retryAfter)
+			}
+			// The jitter is random, so check the bounds over several draws
+			for i := 0; i < 50; i++ {
+				wait := transport.backoff(tt.attempt, resp)
+				if wait < tt.wantMin || wait > tt.wantMax {
+					t.Fatalf("backoff = %s, want between %s and %s", wait, tt.wantMin, tt.wantMax)
+				}
+			}
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		value  string
+		want   time.Duration
+		wantOk bool
+	}{
+		{"", 0, false},
+		{"0", 0, true},
+		{"120", 2 * time.Minute, true},
+		{"-1", -time.Second, false},
+		{"1.5", 0, false},
+		{"soon", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, ok := parseRetryAfter(tt.value)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("parseRetryAfter(%q) = %s, %t, want %s, %t", tt.value, got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+
+	t.Run("http date", func(t *testing.T) {
+		got, ok := parseRetryAfter(time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+		if !ok || got <= 50*time.Second || got > time.Minute {
+			t.Errorf("parseRetryAfter(date in a minute) = %s, %t", got, ok)
+		}
+	})
+}
+
+// generateCertificate returns a self-signed certificate and its key, both PEM encoded.
+func generateCertificate(t *testing.T) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform-provider-unleash"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPem := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+	return string(certPem), string(keyPem)
+}
+
+func TestReadPem(t *testing.T) {
+	certPem, _ := generateCertificate(t)
+	path := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(path, []byte(certPem), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{"inline", certPem, certPem, false},
+		{"file", path, certPem, false},
+		{"missing file", filepath.Join(t.TempDir(), "missing.pem"), "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := readPem(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("readPem() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if string(got) != tt.want {
+				t.Errorf("readPem() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewBaseTransport(t *testing.T) {
+	certPem, keyPem := generateCertificate(t)
+	_, otherKeyPem := generateCertificate(t)
+	dir := t.TempDir()
+	certPath := filepath.Join(dir, "client.pem")
+	keyPath := filepath.Join(dir, "client.key")
+	if err := os.WriteFile(certPath, []byte(certPem), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyPath, []byte(keyPem), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		options transportOptions
+		wantErr string
+	}{
+		{"defaults", transportOptions{}, ""},
+		{"inline ca cert", transportOptions{caCert: certPem}, ""},
+		{"ca cert file", transportOptions{caCert: certPath}, ""},
+		{"ca cert without pem", transportOptions{caCert: keyPath}, "ca_cert does not contain any PEM encoded certificate"},
+		{"missing ca cert", transportOptions{caCert: filepath.Join(dir, "missing.pem")}, "reading ca_cert"},
+		{"inline key pair", transportOptions{clientCert: certPem, clientKey: keyPem}, ""},
+		{"key pair files", transportOptions{clientCert: certPath, clientKey: keyPath}, ""},
+		{"mismatched key pair", transportOptions{clientCert: certPem, clientKey: otherKeyPem}, "loading the client certificate"},
+		{"missing client key", transportOptions{clientCert: certPem}, "reading client_key"},
+		{"invalid proxy", transportOptions{proxyUrl: "://proxy"}, "parsing proxy_url"},
+		{"headers", transportOptions{headers: map[string]string{"X-Test": "yes"}}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newBaseTransport(tt.options)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
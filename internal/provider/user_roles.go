@@ -1,7 +1,60 @@
-package provider
-
-var rolesLookup = map[string]int{
-	"Admin":  1,
-	"Editor": 2,
-	"Viewer": 3,
-}
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	openapiclient "github.com/Unleash/unleash-server-api-go/client"
+)
+
+// getRoles returns all the roles, predefined and custom, known by the server.
+func getRoles(ctx context.Context, client *openapiclient.APIClient) ([]openapiclient.RoleSchema, error) {
+	roles, _, err := client.UsersAPI.GetRoles(ctx).Execute()
+	if err != nil {
+		return nil, err
+	}
+	return roles.Roles, nil
+}
+
+// findRole looks a role up by its id or by its name.
+func findRole(roles []openapiclient.RoleSchema, idOrName string) (*openapiclient.RoleSchema, error) {
+	id, parseErr := strconv.ParseInt(idOrName, 10, 32)
+	for i, role := range roles {
+		if (parseErr == nil && role.Id == int32(id)) || role.Name == idOrName {
+			return &roles[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrRoleNotFound, idOrName)
+}
+
+// roleName returns the name of the role with the given id, or an empty string when there is no such role.
+func roleName(roles []openapiclient.RoleSchema, id int32) string {
+	for _, role := range roles {
+		if role.Id == id {
+			return role.Name
+		}
+	}
+	return ""
+}
+
+// flattenRole returns the role in the same form, id or name, as the given one. The name is used when none is given,
+// such as when importing.
+func flattenRole(roles []openapiclient.RoleSchema, given string, roleId int32) string {
+	if _, err := strconv.Atoi(given); err == nil {
+		return strconv.Itoa(int(roleId))
+	}
+	return roleName(roles, roleId)
+}
+
+// flattenRootRole returns the root role in the same form, id or name, as the given one.
+func flattenRootRole(ctx context.Context, client *openapiclient.APIClient, given string, roleId int32) (string, error) {
+	if _, err := strconv.Atoi(given); err == nil {
+		return strconv.Itoa(int(roleId)), nil
+	}
+	roles, err := getRoles(ctx, client)
+	if err != nil {
+		return "", err
+	}
+	return roleName(roles, roleId), nil
+}